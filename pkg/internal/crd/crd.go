@@ -0,0 +1,145 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package crd reads the CustomResourceDefinitions to generate the models of existing APIs from.
+package crd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	apiVersionV1 = "apiextensions.k8s.io/v1"
+	crdKind      = "CustomResourceDefinition"
+
+	// ScopeNamespaced is the scope of the namespaced custom resources
+	ScopeNamespaced = "Namespaced"
+)
+
+// CustomResourceDefinition is the subset of an apiextensions.k8s.io/v1 CustomResourceDefinition the models are
+// generated from
+type CustomResourceDefinition struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Spec       Spec   `json:"spec"`
+}
+
+// Spec describes the custom resources defined by the CRD
+type Spec struct {
+	Group    string    `json:"group"`
+	Names    Names     `json:"names"`
+	Scope    string    `json:"scope"`
+	Versions []Version `json:"versions"`
+}
+
+// Names are the names of the custom resources defined by the CRD
+type Names struct {
+	Kind       string   `json:"kind"`
+	Plural     string   `json:"plural"`
+	ShortNames []string `json:"shortNames,omitempty"`
+	Categories []string `json:"categories,omitempty"`
+}
+
+// Version is a version of the custom resources defined by the CRD
+type Version struct {
+	Name    string      `json:"name"`
+	Served  bool        `json:"served"`
+	Storage bool        `json:"storage"`
+	Schema  *Validation `json:"schema,omitempty"`
+}
+
+// Validation holds the schema of a version
+type Validation struct {
+	OpenAPIV3Schema *JSONSchema `json:"openAPIV3Schema,omitempty"`
+}
+
+// JSONSchema is the subset of the structural OpenAPI v3 schemas the models are generated from
+type JSONSchema struct {
+	Type                 string                `json:"type,omitempty"`
+	Format               string                `json:"format,omitempty"`
+	Description          string                `json:"description,omitempty"`
+	Properties           map[string]JSONSchema `json:"properties,omitempty"`
+	Required             []string              `json:"required,omitempty"`
+	Items                *JSONSchema           `json:"items,omitempty"`
+	AdditionalProperties *SchemaOrBool         `json:"additionalProperties,omitempty"`
+	Enum                 []json.RawMessage     `json:"enum,omitempty"`
+	Minimum              *float64              `json:"minimum,omitempty"`
+	Maximum              *float64              `json:"maximum,omitempty"`
+	Pattern              string                `json:"pattern,omitempty"`
+
+	XIntOrString           bool `json:"x-kubernetes-int-or-string,omitempty"`
+	XPreserveUnknownFields bool `json:"x-kubernetes-preserve-unknown-fields,omitempty"`
+}
+
+// SchemaOrBool is the value of additionalProperties, either a schema or whether any property is allowed
+type SchemaOrBool struct {
+	Allows bool
+	Schema *JSONSchema
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (s *SchemaOrBool) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &s.Allows); err == nil {
+		return nil
+	}
+
+	s.Allows = true
+	s.Schema = &JSONSchema{}
+	return json.Unmarshal(data, s.Schema)
+}
+
+// Parse reads a CRD from its YAML or JSON manifest
+func Parse(data []byte) (*CustomResourceDefinition, error) {
+	crd := &CustomResourceDefinition{}
+	if err := yaml.Unmarshal(data, crd); err != nil {
+		return nil, fmt.Errorf("error parsing CRD: %w", err)
+	}
+
+	if crd.Kind != crdKind {
+		return nil, fmt.Errorf("expected a %s, found kind %q", crdKind, crd.Kind)
+	}
+	if crd.APIVersion != apiVersionV1 {
+		return nil, fmt.Errorf("unsupported CRD API version %q, only %s is supported", crd.APIVersion, apiVersionV1)
+	}
+	if crd.Spec.Group == "" || crd.Spec.Names.Kind == "" || crd.Spec.Names.Plural == "" {
+		return nil, fmt.Errorf("CRD must define spec.group, spec.names.kind and spec.names.plural")
+	}
+	if len(crd.Spec.Versions) == 0 {
+		return nil, fmt.Errorf("CRD must define at least one version")
+	}
+
+	return crd, nil
+}
+
+// Namespaced returns true if the custom resources are namespaced
+func (crd *CustomResourceDefinition) Namespaced() bool {
+	return crd.Spec.Scope == ScopeNamespaced
+}
+
+// Version returns the version with the given name, or the storage version if name is empty
+func (crd *CustomResourceDefinition) Version(name string) (*Version, error) {
+	for i, version := range crd.Spec.Versions {
+		if name == "" && version.Storage || name != "" && version.Name == name {
+			return &crd.Spec.Versions[i], nil
+		}
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("CRD %s.%s has no storage version", crd.Spec.Names.Plural, crd.Spec.Group)
+	}
+	return nil, fmt.Errorf("CRD %s.%s has no version %q", crd.Spec.Names.Plural, crd.Spec.Group, name)
+}
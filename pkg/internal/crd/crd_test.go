@@ -0,0 +1,188 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crd
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/templates/model"
+)
+
+const memcachedCRD = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: memcacheds.cache.example.com
+spec:
+  group: cache.example.com
+  names:
+    kind: Memcached
+    plural: memcacheds
+  scope: Namespaced
+  versions:
+  - name: v1alpha1
+    served: true
+    storage: false
+  - name: v1
+    served: true
+    storage: true
+    schema:
+      openAPIV3Schema:
+        type: object
+        properties:
+          spec:
+            type: object
+            required:
+            - size
+            properties:
+              size:
+                type: integer
+                format: int32
+                minimum: 1
+                maximum: 10
+              image-name:
+                type: string
+                pattern: ^[a-z]+$
+              pullPolicy:
+                type: string
+                enum: [Always, IfNotPresent]
+              port:
+                x-kubernetes-int-or-string: true
+              labels:
+                type: object
+                additionalProperties:
+                  type: string
+              containers:
+                type: array
+                items:
+                  type: object
+                  properties:
+                    name:
+                      type: string
+                      description: Name of the container
+          status:
+            type: object
+            properties:
+              nodes:
+                type: array
+                items:
+                  type: string
+              ready:
+                type: boolean
+              extra:
+                type: object
+                x-kubernetes-preserve-unknown-fields: true
+`
+
+var _ = Describe("CustomResourceDefinition", func() {
+	var crd *CustomResourceDefinition
+
+	BeforeEach(func() {
+		var err error
+		crd, err = Parse([]byte(memcachedCRD))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("reads the names and scope", func() {
+		Expect(crd.Spec.Group).To(Equal("cache.example.com"))
+		Expect(crd.Spec.Names.Kind).To(Equal("Memcached"))
+		Expect(crd.Spec.Names.Plural).To(Equal("memcacheds"))
+		Expect(crd.Namespaced()).To(BeTrue())
+	})
+
+	It("returns the storage version by default", func() {
+		version, err := crd.Version("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(version.Name).To(Equal("v1"))
+
+		version, err = crd.Version("v1alpha1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(version.Name).To(Equal("v1alpha1"))
+
+		_, err = crd.Version("v2")
+		Expect(err).To(MatchError(ContainSubstring(`has no version "v2"`)))
+	})
+
+	It("rejects the CRDs which are not apiextensions.k8s.io/v1", func() {
+		_, err := Parse([]byte("apiVersion: apiextensions.k8s.io/v1beta1\nkind: CustomResourceDefinition\n"))
+		Expect(err).To(MatchError(ContainSubstring("unsupported CRD API version")))
+
+		_, err = Parse([]byte("apiVersion: v1\nkind: ConfigMap\n"))
+		Expect(err).To(MatchError(ContainSubstring("expected a CustomResourceDefinition")))
+	})
+
+	It("converts the schema into the model properties", func() {
+		version, err := crd.Version("")
+		Expect(err).NotTo(HaveOccurred())
+		schema, err := version.ModelSchema("Memcached")
+		Expect(err).NotTo(HaveOccurred())
+
+		spec := schema.Spec
+		Expect(spec.Fields).To(HaveLen(6))
+		types := map[string]string{}
+		for _, field := range spec.Fields {
+			types[field.Name] = field.Type.Java()
+		}
+		Expect(types).To(Equal(map[string]string{
+			"containers": "List<Container>",
+			"image-name": "String",
+			"labels":     "Map<String, String>",
+			"port":       "IntOrString",
+			"pullPolicy": "PullPolicy",
+			"size":       "Integer",
+		}))
+
+		size := spec.Fields[5]
+		Expect(size.Required).To(BeTrue())
+		Expect(size.Annotations()).To(Equal([]string{"@Required", "@Min(1.0)", "@Max(10.0)"}))
+		Expect(spec.Fields[1].Annotations()).To(Equal([]string{`@JsonProperty("image-name")`, `@Pattern("^[a-z]+$")`}))
+
+		Expect(spec.Classes).To(Equal([]model.Class{
+			{Name: "Container", Fields: []model.Field{
+				{Name: "name", Type: model.Type{Name: model.TypeString}, Description: "Name of the container"},
+			}},
+			{Name: "PullPolicy", EnumValues: []string{"Always", "IfNotPresent"}},
+		}))
+
+		status := schema.Status
+		Expect(status.Classes).To(BeEmpty())
+		Expect(status.Fields).To(HaveLen(3))
+		Expect(status.Fields[0].Type.Java()).To(Equal("Map<String, Object>"))
+		Expect(status.Fields[1].Type.Java()).To(Equal("List<String>"))
+		Expect(status.Fields[2].Type.Java()).To(Equal("Boolean"))
+	})
+
+	It("prefixes the nested class names which are already used", func() {
+		version := Version{Name: "v1", Schema: &Validation{OpenAPIV3Schema: &JSONSchema{
+			Properties: map[string]JSONSchema{"spec": {Type: "object", Properties: map[string]JSONSchema{
+				"a": {Type: "object", Properties: map[string]JSONSchema{
+					"config": {Type: "object", Properties: map[string]JSONSchema{"x": {Type: "string"}}},
+				}},
+				"b": {Type: "object", Properties: map[string]JSONSchema{
+					"config": {Type: "object", Properties: map[string]JSONSchema{"y": {Type: "string"}}},
+				}},
+				"string": {Type: "object", Properties: map[string]JSONSchema{"z": {Type: "string"}}},
+			}}},
+		}}}
+
+		schema, err := version.ModelSchema("Memcached")
+		Expect(err).NotTo(HaveOccurred())
+		var names []string
+		for _, class := range schema.Spec.Classes {
+			names = append(names, class.Name)
+		}
+		Expect(names).To(Equal([]string{"A", "Config", "B", "BConfig", "String2"}))
+	})
+})
@@ -0,0 +1,223 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/templates/model"
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/util"
+)
+
+// ModelSchema returns the properties of the spec and status classes of kind, generated from the schema of the version
+func (v *Version) ModelSchema(kind string) (model.Schema, error) {
+	var schema model.Schema
+	if v.Schema == nil || v.Schema.OpenAPIV3Schema == nil {
+		return schema, nil
+	}
+	root := v.Schema.OpenAPIV3Schema
+
+	var err error
+	if spec, ok := root.Properties["spec"]; ok {
+		if schema.Spec, err = newConverter(kind, kind+"Spec").properties(&spec); err != nil {
+			return schema, fmt.Errorf("error converting the spec schema of version %s: %w", v.Name, err)
+		}
+	}
+	if status, ok := root.Properties["status"]; ok {
+		if schema.Status, err = newConverter(kind, kind+"Status").properties(&status); err != nil {
+			return schema, fmt.Errorf("error converting the status schema of version %s: %w", v.Name, err)
+		}
+	}
+
+	return schema, nil
+}
+
+// converter converts the schema of a spec or status into the properties of its class, the objects and enums it
+// declares becoming classes nested in it
+type converter struct {
+	classes []model.Class
+	names   map[string]bool
+}
+
+func newConverter(reserved ...string) *converter {
+	c := &converter{names: map[string]bool{}}
+	for _, name := range reserved {
+		c.names[name] = true
+	}
+	return c
+}
+
+func (c *converter) properties(s *JSONSchema) (model.Properties, error) {
+	fields, err := c.fields(s, "")
+	if err != nil {
+		return model.Properties{}, err
+	}
+	return model.Properties{Fields: fields, Classes: c.classes}, nil
+}
+
+// fields converts the properties of an object schema, sorted by name, into fields
+func (c *converter) fields(s *JSONSchema, parent string) ([]model.Field, error) {
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	required := map[string]bool{}
+	for _, name := range s.Required {
+		required[name] = true
+	}
+
+	fields := make([]model.Field, 0, len(names))
+	for _, name := range names {
+		property := s.Properties[name]
+		t, err := c.typeOf(&property, util.ToClassname(name), parent)
+		if err != nil {
+			return nil, fmt.Errorf("property %q: %w", name, err)
+		}
+
+		fields = append(fields, model.Field{
+			Name:        name,
+			Type:        t,
+			Description: property.Description,
+			Required:    required[name],
+			Minimum:     property.Minimum,
+			Maximum:     property.Maximum,
+			Pattern:     property.Pattern,
+		})
+	}
+	return fields, nil
+}
+
+// typeOf returns the type of the values of the schema, adding a class named after the property if the schema
+// declares an object or an enum
+func (c *converter) typeOf(s *JSONSchema, name, parent string) (model.Type, error) {
+	if s.XIntOrString {
+		return model.Type{Name: model.TypeIntOrString}, nil
+	}
+
+	switch s.Type {
+	case "string":
+		if len(s.Enum) != 0 {
+			return c.enum(s, name, parent)
+		}
+		return model.Type{Name: model.TypeString}, nil
+	case "integer":
+		if s.Format == "int64" {
+			return model.Type{Name: model.TypeLong}, nil
+		}
+		return model.Type{Name: model.TypeInt}, nil
+	case "number":
+		if s.Format == "float" {
+			return model.Type{Name: model.TypeFloat}, nil
+		}
+		return model.Type{Name: model.TypeDouble}, nil
+	case "boolean":
+		return model.Type{Name: model.TypeBoolean}, nil
+	case "array":
+		elem := model.Type{Name: model.TypeObject}
+		if s.Items != nil {
+			var err error
+			if elem, err = c.typeOf(s.Items, singular(name), parent); err != nil {
+				return model.Type{}, err
+			}
+		}
+		return model.Type{List: true, Elem: &elem}, nil
+	case "object", "":
+		if len(s.Properties) != 0 {
+			return c.object(s, name, parent)
+		}
+		if s.Type == "" {
+			return model.Type{Name: model.TypeObject}, nil
+		}
+		elem := model.Type{Name: model.TypeObject}
+		if s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil {
+			var err error
+			if elem, err = c.typeOf(s.AdditionalProperties.Schema, name+"Value", parent); err != nil {
+				return model.Type{}, err
+			}
+		}
+		return model.Type{Map: true, Elem: &elem}, nil
+	}
+
+	return model.Type{}, fmt.Errorf("unsupported schema type %q", s.Type)
+}
+
+func (c *converter) object(s *JSONSchema, name, parent string) (model.Type, error) {
+	name = c.className(name, parent)
+
+	// Add the class before the ones it declares, to generate the classes in the order they are used
+	i := len(c.classes)
+	c.classes = append(c.classes, model.Class{Name: name})
+	fields, err := c.fields(s, name)
+	if err != nil {
+		return model.Type{}, err
+	}
+	c.classes[i].Fields = fields
+
+	return model.Type{Name: name}, nil
+}
+
+func (c *converter) enum(s *JSONSchema, name, parent string) (model.Type, error) {
+	values := make([]string, 0, len(s.Enum))
+	for _, raw := range s.Enum {
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return model.Type{}, fmt.Errorf("enum value %s is not a string", raw)
+		}
+		values = append(values, value)
+	}
+
+	name = c.className(name, parent)
+	c.classes = append(c.classes, model.Class{Name: name, EnumValues: values})
+	return model.Type{Name: name}, nil
+}
+
+// className returns a unique class name for a property, prefixing it with the class name of its parent if another
+// class already has the name. No nested class is named after the classes the generated sources use, which it would
+// shadow.
+func (c *converter) className(name, parent string) string {
+	if c.taken(name) && parent != "" {
+		name = parent + name
+	}
+	unique := name
+	for i := 2; c.taken(unique); i++ {
+		unique = fmt.Sprintf("%s%d", name, i)
+	}
+	c.names[unique] = true
+	return unique
+}
+
+// taken returns true if no nested class may be named name
+func (c *converter) taken(name string) bool {
+	return c.names[name] || util.IsReservedClassName(name)
+}
+
+// singular returns the class name of the items of a list property, naively singularizing the property name
+func singular(name string) string {
+	switch {
+	case strings.HasSuffix(name, "ies") && len(name) > 3:
+		return strings.TrimSuffix(name, "ies") + "y"
+	case strings.HasSuffix(name, "sses"), strings.HasSuffix(name, "xes"),
+		strings.HasSuffix(name, "ches"), strings.HasSuffix(name, "shes"):
+		return strings.TrimSuffix(name, "es")
+	case strings.HasSuffix(name, "s") && !strings.HasSuffix(name, "ss") && len(name) > 1:
+		return strings.TrimSuffix(name, "s")
+	}
+	return name + "Item"
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package josdk
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	"sigs.k8s.io/kubebuilder/v3/pkg/model/resource"
+)
+
+var _ = Describe("createAPISubcommand", func() {
+	var (
+		testConfig config.Config
+		subcommand createAPISubcommand
+	)
+
+	newResource := func(group, kind string) *resource.Resource {
+		return &resource.Resource{
+			GVK: resource.GVK{
+				Group:   group,
+				Domain:  "example.com",
+				Version: "v1",
+				Kind:    kind,
+			},
+			Plural:   resource.RegularPlural(kind),
+			API:      &resource.API{},
+			Webhooks: &resource.Webhooks{},
+		}
+	}
+
+	BeforeEach(func() {
+		testConfig, _ = config.New(config.Version{Number: 3})
+		Expect(testConfig.SetDomain("example.com")).To(Succeed())
+
+		subcommand = createAPISubcommand{variant: testVariant}
+		subcommand.BindFlags(pflag.NewFlagSet("testFlag", -1))
+		Expect(subcommand.InjectConfig(testConfig)).To(Succeed())
+	})
+
+	It("should load the plugin settings", func() {
+		Expect(subcommand.pluginConfig.Package).To(Equal("com.example"))
+	})
+
+	It("should accept a new resource", func() {
+		res := newResource("cache", "Memcached")
+		Expect(subcommand.InjectResource(res)).To(Succeed())
+		Expect(res.API.Namespaced).To(BeTrue())
+		Expect(res.API.CRDVersion).To(Equal("v1"))
+	})
+
+	It("should reject an existing API", func() {
+		res := newResource("cache", "Memcached")
+		res.API = &resource.API{CRDVersion: "v1", Namespaced: true}
+		Expect(testConfig.AddResource(*res)).To(Succeed())
+
+		Expect(subcommand.InjectResource(newResource("cache", "Memcached"))).NotTo(Succeed())
+	})
+
+	It("should reject a second group unless the project is multigroup", func() {
+		Expect(testConfig.AddResource(*newResource("cache", "Memcached"))).To(Succeed())
+
+		Expect(subcommand.InjectResource(newResource("web", "Site"))).NotTo(Succeed())
+	})
+})
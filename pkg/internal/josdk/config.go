@@ -0,0 +1,85 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package josdk
+
+import (
+	"errors"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/util"
+)
+
+// PluginConfig holds the project-wide settings of a plugin, stored in the PROJECT file
+type PluginConfig struct {
+	// Package is the Java package of the generated sources
+	Package string `json:"package,omitempty"`
+
+	// SpringBootVersion is the Spring Boot version the project is built with, if it is based on Spring Boot
+	SpringBootVersion string `json:"springBootVersion,omitempty"`
+
+	// OperatorSDKVersion is the java-operator-sdk version the project is built with, if it depends on it directly
+	OperatorSDKVersion string `json:"operatorSDKVersion,omitempty"`
+}
+
+// Templates supplies the files which are specific to the framework a plugin builds the projects on
+type Templates interface {
+	// NewPluginConfig returns the settings of a new project whose sources are generated in javaPackage
+	NewPluginConfig(javaPackage string) PluginConfig
+
+	// Init returns the templates of the files of a new project, besides the shared ones
+	Init(cfg config.Config, pluginConfig PluginConfig) []machinery.Builder
+
+	// API returns the templates of the reconciler of a new API, whose custom resource class is className, and of
+	// the files registering it
+	API(cfg config.Config, pluginConfig PluginConfig, className string) []machinery.Builder
+}
+
+// Variant describes a plugin built on the shared subcommands
+type Variant struct {
+	// Key is the key of the plugin, under which its settings are stored in the PROJECT file
+	Key string
+
+	// Name is the name of the plugin passed to --plugins in the examples
+	Name string
+
+	// InitDescription describes the project init writes, and CreateAPIDescription the reconciler create api writes
+	InitDescription      string
+	CreateAPIDescription string
+
+	// Templates supplies the files specific to the plugin
+	Templates Templates
+}
+
+// loadPluginConfig returns the plugin settings stored in the project configuration, deriving the
+// settings which are not stored from the project.
+func (v Variant) loadPluginConfig(c config.Config) (PluginConfig, error) {
+	cfg := PluginConfig{}
+	if err := c.DecodePluginConfig(v.Key, &cfg); err != nil && !errors.As(err, &config.PluginKeyNotFoundError{}) {
+		return cfg, err
+	}
+
+	if cfg.Package == "" {
+		cfg.Package = util.ReverseDomain(util.SanitizeDomain(c.GetDomain()))
+	}
+
+	return cfg, nil
+}
+
+// savePluginConfig stores the plugin settings in the project configuration
+func (v Variant) savePluginConfig(c config.Config, cfg PluginConfig) error {
+	return c.EncodePluginConfig(v.Key, cfg)
+}
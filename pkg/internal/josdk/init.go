@@ -0,0 +1,180 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package josdk
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v3/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v3/pkg/plugin"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/util"
+)
+
+const (
+	groupFlag   = "group"
+	versionFlag = "version"
+	kindFlag    = "kind"
+)
+
+type initSubcommand struct {
+	variant       Variant
+	apiSubcommand createAPISubcommand
+
+	config       config.Config
+	pluginConfig PluginConfig
+
+	// For help text.
+	commandName string
+
+	// Flags
+	group       string
+	domain      string
+	version     string
+	kind        string
+	projectName string
+}
+
+var (
+	_ plugin.InitSubcommand = &initSubcommand{}
+)
+
+// NewInitSubcommand returns the init subcommand of the plugin described by variant
+func NewInitSubcommand(variant Variant) plugin.InitSubcommand {
+	return &initSubcommand{variant: variant, apiSubcommand: createAPISubcommand{variant: variant}}
+}
+
+func (p *initSubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
+	subcmdMeta.Description = p.variant.InitDescription + `
+If --group, --version and --kind are set, the API is created in the same run,
+as if running "create api" right after init.
+`
+	subcmdMeta.Examples = fmt.Sprintf(`  # Initialize a new project
+  %[1]s init --plugins %[2]s --domain example.com
+
+  # Initialize a new project and create its first API
+  %[1]s init --plugins %[2]s --domain example.com --group cache --version v1 --kind Memcached
+`, cliMeta.CommandName, p.variant.Name)
+
+	p.commandName = cliMeta.CommandName
+}
+
+func (p *initSubcommand) BindFlags(fs *pflag.FlagSet) {
+	fs.SortFlags = false
+	fs.StringVar(&p.domain, "domain", "my.domain", "domain for groups")
+	fs.StringVar(&p.projectName, "project-name", "", "name of this project, the default being directory name")
+
+	fs.StringVar(&p.group, groupFlag, "", "resource Group")
+	fs.StringVar(&p.version, versionFlag, "", "resource Version")
+	fs.StringVar(&p.kind, kindFlag, "", "resource Kind")
+	p.apiSubcommand.BindFlags(fs)
+}
+
+func (p *initSubcommand) InjectConfig(c config.Config) error {
+	p.config = c
+
+	if err := p.config.SetDomain(p.domain); err != nil {
+		return err
+	}
+
+	// Assign a default project name
+	if p.projectName == "" {
+		dir, err := os.Getwd()
+		if err != nil {
+			return fmt.Errorf("error getting current directory: %v", err)
+		}
+		p.projectName = strings.ToLower(filepath.Base(dir))
+	}
+	// Check if the project name is a valid k8s namespace (DNS 1123 label).
+	if err := validation.IsDNS1123Label(p.projectName); err != nil {
+		return fmt.Errorf("project name (%s) is invalid: %v", p.projectName, err)
+	}
+	if err := p.config.SetProjectName(p.projectName); err != nil {
+		return err
+	}
+
+	p.pluginConfig = p.variant.Templates.NewPluginConfig(util.ReverseDomain(util.SanitizeDomain(p.config.GetDomain())))
+	if err := p.variant.savePluginConfig(p.config, p.pluginConfig); err != nil {
+		return err
+	}
+
+	if p.hasAPI() {
+		if err := p.injectAPI(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hasAPI returns whether an API should be created along with the project
+func (p *initSubcommand) hasAPI() bool {
+	return p.group != "" || p.version != "" || p.kind != ""
+}
+
+// injectAPI prepares the create api subcommand for the resource given to init
+func (p *initSubcommand) injectAPI() error {
+	if p.version == "" || p.kind == "" {
+		return fmt.Errorf("--%s and --%s are required to create an API on init", versionFlag, kindFlag)
+	}
+
+	if err := p.apiSubcommand.InjectConfig(p.config); err != nil {
+		return err
+	}
+
+	res := &resource.Resource{
+		GVK: resource.GVK{
+			Group:   strings.TrimSpace(p.group),
+			Domain:  p.config.GetDomain(),
+			Version: strings.TrimSpace(p.version),
+			Kind:    strings.TrimSpace(p.kind),
+		},
+		Plural:   resource.RegularPlural(strings.TrimSpace(p.kind)),
+		API:      &resource.API{},
+		Webhooks: &resource.Webhooks{},
+	}
+	return p.apiSubcommand.InjectResource(res)
+}
+
+func (p *initSubcommand) Scaffold(fs machinery.Filesystem) error {
+	scaffolder := NewInitScaffolder(p.config, p.pluginConfig, p.variant.Templates)
+	scaffolder.InjectFS(fs)
+	if err := scaffolder.Scaffold(); err != nil {
+		return err
+	}
+
+	if p.hasAPI() {
+		return p.apiSubcommand.Scaffold(fs)
+	}
+
+	return nil
+}
+
+func (p *initSubcommand) PostScaffold() error {
+	if p.hasAPI() {
+		fmt.Printf("Next: implement the %s reconciler and run the operator with:\n$ make run\n", p.kind)
+		return nil
+	}
+
+	fmt.Printf("Next: define a resource with:\n$ %s create api\n", p.commandName)
+	return nil
+}
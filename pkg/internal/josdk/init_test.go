@@ -0,0 +1,89 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package josdk
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+var _ = Describe("initSubcommand", func() {
+	var testConfig config.Config
+
+	BeforeEach(func() {
+		testConfig, _ = config.New(config.Version{Number: 3})
+	})
+
+	It("should bind the flags", func() {
+		subcommand := initSubcommand{variant: testVariant}
+		flagSet := pflag.NewFlagSet("testFlag", -1)
+		subcommand.BindFlags(flagSet)
+		Expect(flagSet.SortFlags).To(BeFalse())
+		Expect(subcommand.domain).To(Equal("my.domain"))
+		Expect(subcommand.apiSubcommand.options.Namespaced).To(BeTrue())
+	})
+
+	It("should record the plugin settings", func() {
+		subcommand := initSubcommand{variant: testVariant, domain: "example.com", projectName: "memcached-operator"}
+		Expect(subcommand.InjectConfig(testConfig)).To(Succeed())
+		Expect(testConfig.GetProjectName()).To(Equal("memcached-operator"))
+
+		pluginConfig, err := testVariant.loadPluginConfig(testConfig)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pluginConfig).To(Equal(PluginConfig{
+			Package:            "com.example",
+			OperatorSDKVersion: "1.0.0",
+		}))
+	})
+
+	It("should reject an invalid project name", func() {
+		subcommand := initSubcommand{variant: testVariant, domain: "example.com", projectName: "?&fail&?"}
+		Expect(subcommand.InjectConfig(testConfig)).NotTo(Succeed())
+	})
+
+	It("should create the API given on init", func() {
+		subcommand := initSubcommand{
+			variant:       testVariant,
+			apiSubcommand: createAPISubcommand{variant: testVariant},
+			domain:        "example.com",
+			projectName:   "memcached-operator",
+			group:         "cache",
+			version:       "v1",
+			kind:          "Memcached",
+		}
+		subcommand.apiSubcommand.BindFlags(pflag.NewFlagSet("testFlag", -1))
+		Expect(subcommand.InjectConfig(testConfig)).To(Succeed())
+
+		fs := machinery.Filesystem{FS: afero.NewMemMapFs()}
+		Expect(subcommand.Scaffold(fs)).To(Succeed())
+		Expect(afero.Exists(fs.FS, "src/main/java/com/example/MemcachedReconciler.java")).To(BeTrue())
+		Expect(testConfig.HasResource(subcommand.apiSubcommand.resource.GVK)).To(BeTrue())
+	})
+
+	It("should require a version and a kind to create an API", func() {
+		subcommand := initSubcommand{
+			variant:     testVariant,
+			domain:      "example.com",
+			projectName: "memcached-operator",
+			group:       "cache",
+			kind:        "Memcached",
+		}
+		Expect(subcommand.InjectConfig(testConfig)).NotTo(Succeed())
+	})
+})
@@ -0,0 +1,46 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package josdk
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/josdk/templates"
+)
+
+func TestJOSDK(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "josdk")
+}
+
+// testTemplates only adds the reconciler to the shared files
+type testTemplates struct{}
+
+func (testTemplates) NewPluginConfig(javaPackage string) PluginConfig {
+	return PluginConfig{Package: javaPackage, OperatorSDKVersion: "1.0.0"}
+}
+
+func (testTemplates) Init(config.Config, PluginConfig) []machinery.Builder { return nil }
+
+func (testTemplates) API(_ config.Config, pluginConfig PluginConfig, className string) []machinery.Builder {
+	return []machinery.Builder{&templates.Controller{Package: pluginConfig.Package, ClassName: className}}
+}
+
+var testVariant = Variant{Key: "test.javaoperatorsdk.io/v1-alpha", Name: "test", Templates: testTemplates{}}
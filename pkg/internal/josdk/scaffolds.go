@@ -0,0 +1,120 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package josdk
+
+import (
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v3/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v3/pkg/plugins"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/josdk/templates"
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/templates/model"
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/util"
+)
+
+var (
+	_ plugins.Scaffolder = &initScaffolder{}
+	_ plugins.Scaffolder = &apiScaffolder{}
+)
+
+type initScaffolder struct {
+	fs           machinery.Filesystem
+	config       config.Config
+	pluginConfig PluginConfig
+	templates    Templates
+}
+
+// NewInitScaffolder returns a new plugins.Scaffolder for project initialization operations, writing the shared
+// files along with the ones of templates
+func NewInitScaffolder(config config.Config, pluginConfig PluginConfig, templates Templates) plugins.Scaffolder {
+	return &initScaffolder{
+		config:       config,
+		pluginConfig: pluginConfig,
+		templates:    templates,
+	}
+}
+
+// InjectFS implements Scaffolder
+func (s *initScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Scaffold implements Scaffolder
+func (s *initScaffolder) Scaffold() error {
+	// Initialize the machinery.Scaffold that will write the files to disk
+	scaffold := machinery.NewScaffold(s.fs,
+		// NOTE: kubebuilder's default permissions are only for root users
+		machinery.WithDirectoryPermissions(0755),
+		machinery.WithFilePermissions(0644),
+		machinery.WithConfig(s.config),
+	)
+
+	initTemplates := []machinery.Builder{&templates.GitIgnore{}}
+	initTemplates = append(initTemplates, s.templates.Init(s.config, s.pluginConfig)...)
+	return scaffold.Execute(initTemplates...)
+}
+
+type apiScaffolder struct {
+	fs machinery.Filesystem
+
+	config       config.Config
+	pluginConfig PluginConfig
+	resource     resource.Resource
+	templates    Templates
+}
+
+// NewCreateAPIScaffolder returns a new plugins.Scaffolder for API creation operations, writing the models of the
+// resource along with the reconciler of templates
+func NewCreateAPIScaffolder(cfg config.Config, pluginConfig PluginConfig, res resource.Resource,
+	templates Templates) plugins.Scaffolder {
+	return &apiScaffolder{
+		config:       cfg,
+		pluginConfig: pluginConfig,
+		resource:     res,
+		templates:    templates,
+	}
+}
+
+// InjectFS implements Scaffolder
+func (s *apiScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Scaffold implements Scaffolder
+func (s *apiScaffolder) Scaffold() error {
+	if err := s.config.UpdateResource(s.resource); err != nil {
+		return err
+	}
+
+	// Initialize the machinery.Scaffold that will write the files to disk
+	scaffold := machinery.NewScaffold(s.fs,
+		// NOTE: kubebuilder's default permissions are only for root users
+		machinery.WithDirectoryPermissions(0755),
+		machinery.WithFilePermissions(0644),
+		machinery.WithConfig(s.config),
+		machinery.WithResource(&s.resource),
+	)
+
+	// Kinds clashing with the classes the generated sources use are suffixed, the model declaring its kind
+	className, _ := util.KindClassName(s.resource.Kind)
+	apiTemplates := []machinery.Builder{
+		&model.Model{Package: s.pluginConfig.Package, ClassName: className, Storage: true},
+		&model.ModelSpec{Package: s.pluginConfig.Package, ClassName: className},
+		&model.ModelStatus{Package: s.pluginConfig.Package, ClassName: className},
+	}
+	apiTemplates = append(apiTemplates, s.templates.API(s.config, s.pluginConfig, className)...)
+	return scaffold.Execute(apiTemplates...)
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package josdk
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	v3 "sigs.k8s.io/kubebuilder/v3/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v3/pkg/model/resource"
+)
+
+var _ = Describe("scaffolders", func() {
+	var (
+		fs           machinery.Filesystem
+		cfg          config.Config
+		pluginConfig PluginConfig
+	)
+
+	readFile := func(path string) string {
+		contents, err := afero.ReadFile(fs.FS, path)
+		Expect(err).NotTo(HaveOccurred())
+		return string(contents)
+	}
+
+	createAPI := func(kind string) {
+		res := resource.Resource{
+			GVK:    resource.GVK{Group: "cache", Domain: "example.com", Version: "v1", Kind: kind},
+			Plural: resource.RegularPlural(kind),
+			API:    &resource.API{CRDVersion: "v1", Namespaced: true},
+		}
+		scaffolder := NewCreateAPIScaffolder(cfg, pluginConfig, res, testTemplates{})
+		scaffolder.InjectFS(fs)
+		Expect(scaffolder.Scaffold()).To(Succeed())
+		Expect(cfg.HasResource(res.GVK)).To(BeTrue())
+	}
+
+	BeforeEach(func() {
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		cfg = v3.New()
+		Expect(cfg.SetDomain("example.com")).To(Succeed())
+		Expect(cfg.SetProjectName("memcached-operator")).To(Succeed())
+		pluginConfig = testTemplates{}.NewPluginConfig("com.example")
+
+		scaffolder := NewInitScaffolder(cfg, pluginConfig, testTemplates{})
+		scaffolder.InjectFS(fs)
+		Expect(scaffolder.Scaffold()).To(Succeed())
+	})
+
+	It("writes the shared project files", func() {
+		Expect(readFile(".gitignore")).To(ContainSubstring("\ntarget\n"))
+	})
+
+	It("writes the model and the reconciler of an API", func() {
+		createAPI("Memcached")
+
+		Expect(readFile("src/main/java/com/example/Memcached.java")).To(ContainSubstring(
+			"@Kind(\"Memcached\")\npublic class Memcached extends CustomResource<MemcachedSpec, MemcachedStatus> implements Namespaced"))
+		Expect(afero.Exists(fs.FS, "src/main/java/com/example/MemcachedSpec.java")).To(BeTrue())
+		Expect(afero.Exists(fs.FS, "src/main/java/com/example/MemcachedStatus.java")).To(BeTrue())
+		Expect(readFile("src/main/java/com/example/MemcachedReconciler.java")).To(ContainSubstring(
+			"@ControllerConfiguration\npublic class MemcachedReconciler implements Reconciler<Memcached> {"))
+	})
+
+	It("suffixes the classes of kinds clashing with Java classes", func() {
+		createAPI("Class")
+
+		Expect(readFile("src/main/java/com/example/ClassResource.java")).To(ContainSubstring(
+			"@Kind(\"Class\")\npublic class ClassResource extends CustomResource<ClassResourceSpec, ClassResourceStatus>"))
+		Expect(readFile("src/main/java/com/example/ClassResourceReconciler.java")).To(ContainSubstring(
+			"implements Reconciler<ClassResource> {"))
+	})
+})
@@ -0,0 +1,85 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package templates
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/templates/util"
+)
+
+var _ machinery.Template = &Controller{}
+
+// Controller scaffolds the reconciler of a custom resource
+type Controller struct {
+	machinery.TemplateMixin
+
+	// Package is the source files package
+	Package string
+
+	// ClassName is the name of the custom resource class the reconciler handles
+	ClassName string
+
+	// Component registers the reconciler as a Spring component, which the Spring Boot starter picks up
+	Component bool
+}
+
+func (f *Controller) SetTemplateDefaults() error {
+	if f.ClassName == "" {
+		return fmt.Errorf("invalid model name")
+	}
+
+	if f.Path == "" {
+		f.Path = util.PrependJavaPath(f.ClassName+"Reconciler.java", util.AsPath(f.Package))
+	}
+
+	f.TemplateBody = controllerTemplate
+
+	return nil
+}
+
+const controllerTemplate = `package {{ .Package }};
+
+import io.fabric8.kubernetes.client.KubernetesClient;
+import io.javaoperatorsdk.operator.api.reconciler.Context;
+import io.javaoperatorsdk.operator.api.reconciler.ControllerConfiguration;
+import io.javaoperatorsdk.operator.api.reconciler.Reconciler;
+import io.javaoperatorsdk.operator.api.reconciler.UpdateControl;
+{{- if .Component }}
+import org.springframework.stereotype.Component;
+{{- end }}
+
+{{ if .Component }}@Component
+{{ end -}}
+@ControllerConfiguration
+public class {{ .ClassName }}Reconciler implements Reconciler<{{ .ClassName }}> {
+  private final KubernetesClient client;
+
+  public {{ .ClassName }}Reconciler(KubernetesClient client) {
+    this.client = client;
+  }
+
+  // TODO Fill in the rest of the reconciler
+
+  @Override
+  public UpdateControl<{{ .ClassName }}> reconcile({{ .ClassName }} resource, Context context) {
+    // TODO: fill in logic
+
+    return UpdateControl.noUpdate();
+  }
+}
+`
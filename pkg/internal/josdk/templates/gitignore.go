@@ -0,0 +1,54 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package templates
+
+import (
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+var _ machinery.Template = &GitIgnore{}
+
+// GitIgnore scaffolds the .gitignore file
+type GitIgnore struct {
+	machinery.TemplateMixin
+}
+
+// SetTemplateDefaults implements input.Template
+func (f *GitIgnore) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = ".gitignore"
+	}
+
+	f.TemplateBody = gitignoreTemplate
+
+	return nil
+}
+
+const gitignoreTemplate = `
+# Binaries for programs and plugins
+*.exe
+*.exe~
+*.dll
+*.so
+*.dylib
+bin
+target
+
+# editor and IDE paraphernalia
+.idea
+*.swp
+*.swo
+*~
+`
@@ -0,0 +1,205 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var typeAliases = map[string]string{
+	"str":     TypeString,
+	"integer": TypeInt,
+	"int32":   TypeInt,
+	"int64":   TypeLong,
+	"float32": TypeFloat,
+	"float64": TypeDouble,
+	"bool":    TypeBoolean,
+}
+
+// ParseField parses a field declared as name:type[:validations], the validations being a comma separated list of
+// required, min=<number>, max=<number> and pattern=<regex>. The pattern must come last, as it may contain commas.
+//
+// The type is one of the scalar types, e.g. string or int, []<type> for a list, map[string]<type> for a map with
+// string keys, or the name of a class generated in the same package, e.g. MemcachedSpec.
+func ParseField(declaration string) (Field, error) {
+	parts := strings.SplitN(declaration, ":", 3)
+	if len(parts) < 2 {
+		return Field{}, fmt.Errorf("invalid field %q, expected name:type[:validations]", declaration)
+	}
+
+	field := Field{Name: strings.TrimSpace(parts[0])}
+	if !isFieldName(field.Name) {
+		return Field{}, fmt.Errorf("invalid field %q: name %q must start with a letter and only hold letters, digits, "+
+			"'-' and '_'", declaration, field.Name)
+	}
+
+	t, err := parseType(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return Field{}, fmt.Errorf("invalid field %q: %w", declaration, err)
+	}
+	field.Type = t
+
+	if len(parts) == 3 {
+		if err := field.parseValidations(parts[2]); err != nil {
+			return Field{}, fmt.Errorf("invalid field %q: %w", declaration, err)
+		}
+	}
+
+	return field, nil
+}
+
+// ParseFields parses the declarations of the fields of a class, whose names must be unique
+func ParseFields(declarations []string) ([]Field, error) {
+	fields := make([]Field, 0, len(declarations))
+	names := map[string]bool{}
+	for _, declaration := range declarations {
+		field, err := ParseField(declaration)
+		if err != nil {
+			return nil, err
+		}
+		if names[field.VarName()] {
+			return nil, fmt.Errorf("field %q is declared more than once", field.Name)
+		}
+		names[field.VarName()] = true
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+func parseType(s string) (Type, error) {
+	switch {
+	case s == "":
+		return Type{}, fmt.Errorf("type is required")
+	case strings.HasPrefix(s, "[]"):
+		elem, err := parseType(s[len("[]"):])
+		if err != nil {
+			return Type{}, err
+		}
+		return Type{List: true, Elem: &elem}, nil
+	case strings.HasPrefix(s, "map[string]"):
+		elem, err := parseType(s[len("map[string]"):])
+		if err != nil {
+			return Type{}, err
+		}
+		return Type{Map: true, Elem: &elem}, nil
+	case strings.HasPrefix(s, "map["):
+		return Type{}, fmt.Errorf("type %q is invalid, only maps with string keys are supported", s)
+	}
+
+	if alias, ok := typeAliases[s]; ok {
+		s = alias
+	}
+	if _, ok := javaTypes[s]; ok {
+		return Type{Name: s}, nil
+	}
+	if isClassName(s) {
+		return Type{Name: s}, nil
+	}
+
+	return Type{}, fmt.Errorf("type %q is invalid, expected string, int, long, float, double, boolean, "+
+		"int-or-string, object, []<type>, map[string]<type> or a class name", s)
+}
+
+func (f *Field) parseValidations(s string) error {
+	for s != "" {
+		var validation string
+		if strings.HasPrefix(s, "pattern=") {
+			// The pattern consumes the rest of the validations
+			validation, s = s, ""
+		} else if i := strings.Index(s, ","); i >= 0 {
+			validation, s = s[:i], s[i+1:]
+		} else {
+			validation, s = s, ""
+		}
+
+		validation = strings.TrimSpace(validation)
+		key, value := validation, ""
+		if i := strings.Index(validation, "="); i >= 0 {
+			key, value = validation[:i], validation[i+1:]
+		}
+
+		switch key {
+		case "required":
+			f.Required = true
+		case "min", "max":
+			n, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return fmt.Errorf("validation %q must be a number", validation)
+			}
+			if key == "min" {
+				f.Minimum = &n
+			} else {
+				f.Maximum = &n
+			}
+		case "pattern":
+			if value == "" {
+				return fmt.Errorf("validation %q must be a regular expression", validation)
+			}
+			f.Pattern = value
+		default:
+			return fmt.Errorf("unknown validation %q, expected required, min=<number>, max=<number> or "+
+				"pattern=<regex>", validation)
+		}
+	}
+
+	if (f.Minimum != nil || f.Maximum != nil) && !f.Type.isNumber() {
+		return fmt.Errorf("min and max only apply to the int, long, float and double types")
+	}
+	if f.Pattern != "" && (f.Type.List || f.Type.Map || f.Type.Name != TypeString) {
+		return fmt.Errorf("pattern only applies to the string type")
+	}
+	if f.Minimum != nil && f.Maximum != nil && *f.Minimum > *f.Maximum {
+		return fmt.Errorf("min %v is greater than max %v", *f.Minimum, *f.Maximum)
+	}
+	return nil
+}
+
+func (t Type) isNumber() bool {
+	if t.List || t.Map {
+		return false
+	}
+	switch t.Name {
+	case TypeInt, TypeLong, TypeFloat, TypeDouble:
+		return true
+	}
+	return false
+}
+
+func isFieldName(s string) bool {
+	for i, r := range s {
+		switch {
+		case unicode.IsLetter(r):
+		case i > 0 && (unicode.IsDigit(r) || r == '-' || r == '_'):
+		default:
+			return false
+		}
+	}
+	return s != ""
+}
+
+func isClassName(s string) bool {
+	for i, r := range s {
+		switch {
+		case i == 0 && !unicode.IsUpper(r):
+			return false
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_':
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseField", func() {
+	It("parses the scalar, list, map and class types", func() {
+		for declaration, java := range map[string]string{
+			"a:string":                    "String",
+			"a:int":                       "Integer",
+			"a:int64":                     "Long",
+			"a:bool":                      "Boolean",
+			"a:int-or-string":             "IntOrString",
+			"a:[]string":                  "List<String>",
+			"a:map[string]int":            "Map<String, Integer>",
+			"a:map[string][]MemcachedPod": "Map<String, List<MemcachedPod>>",
+		} {
+			field, err := ParseField(declaration)
+			Expect(err).NotTo(HaveOccurred(), declaration)
+			Expect(field.Type.Java()).To(Equal(java), declaration)
+		}
+	})
+
+	It("parses the validations", func() {
+		field, err := ParseField("size:int:required,min=1,max=10")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(field.Annotations()).To(Equal([]string{"@Required", "@Min(1.0)", "@Max(10.0)"}))
+
+		field, err = ParseField("image:string:required,pattern=^[a-z]{1,3}:v[0-9]+$")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(field.Required).To(BeTrue())
+		Expect(field.Pattern).To(Equal("^[a-z]{1,3}:v[0-9]+$"))
+	})
+
+	It("rejects the invalid declarations", func() {
+		for _, declaration := range []string{
+			"size",
+			"1size:int",
+			"size:integer32",
+			"size:map[int]string",
+			"size:lowercase",
+			"size:int:positive",
+			"size:int:min=one",
+			"size:int:min=10,max=1",
+			"size:string:min=1",
+			"size:int:pattern=^[0-9]+$",
+		} {
+			_, err := ParseField(declaration)
+			Expect(err).To(HaveOccurred(), declaration)
+		}
+	})
+
+	It("rejects the duplicate fields", func() {
+		_, err := ParseFields([]string{"size:int", "size:long"})
+		Expect(err).To(MatchError(ContainSubstring("more than once")))
+	})
+})
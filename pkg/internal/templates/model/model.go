@@ -0,0 +1,179 @@
+// Copyright 2021 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v3/pkg/model/resource"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/templates/util"
+)
+
+var _ machinery.Template = &Model{}
+
+type Model struct {
+	machinery.TemplateMixin
+	machinery.ResourceMixin
+
+	// Package is the source files package
+	Package string
+
+	// Name of the operator used for the main file.
+	ClassName string
+
+	// Kotlin indicates that the source file is written in Kotlin instead of Java
+	Kotlin bool
+
+	// Storage indicates that the version is the one the custom resources are stored as.
+	// The other versions are served but not stored.
+	Storage bool
+
+	// DeclarePlural declares the plural of the resource even when it is the one derived from its kind, so that
+	// the CRD generator does not guess a plural of its own
+	DeclarePlural bool
+
+	// ShortNames and Categories are the short names and categories of the CRD, if any
+	ShortNames []string
+	Categories []string
+
+	// NoStatus declares no status class, which leaves the custom resources without the status subresource
+	NoStatus bool
+}
+
+func (f *Model) SetTemplateDefaults() error {
+	if f.ClassName == "" {
+		return fmt.Errorf("invalid model name")
+	}
+
+	if f.Kotlin {
+		if f.Path == "" {
+			f.Path = util.PrependKotlinPath(f.ClassName+".kt", util.AsPath(f.Package))
+		}
+		f.TemplateBody = modelKotlinTemplate
+		return nil
+	}
+
+	if f.Path == "" {
+		f.Path = util.PrependJavaPath(f.ClassName+".java", util.AsPath(f.Package))
+	}
+
+	f.TemplateBody = modelTemplate
+
+	return nil
+}
+
+// VersionAnnotation returns the annotation declaring the version of the model
+func (f *Model) VersionAnnotation() string {
+	storage, served := VersionAnnotations(f.Resource.Version)
+	if f.Storage {
+		return storage
+	}
+	return served
+}
+
+// VersionAnnotations returns the annotations of a model whose version is the storage one, and of a model whose
+// version is only served
+func VersionAnnotations(version string) (storage, served string) {
+	return fmt.Sprintf("@Version(%q)", version), fmt.Sprintf("@Version(value = %q, storage = false, served = true)", version)
+}
+
+// HasCustomPlural returns true if the plural of the resource is not the one derived from its kind
+func (f *Model) HasCustomPlural() bool {
+	return f.Resource.Plural != "" && f.Resource.Plural != resource.RegularPlural(f.Resource.Kind)
+}
+
+// HasPlural returns true if the model declares the plural of the resource
+func (f *Model) HasPlural() bool {
+	return f.Resource.Plural != "" && (f.DeclarePlural || f.HasCustomPlural())
+}
+
+// StatusClassName returns the type of the status of the custom resource, Void if it has none
+func (f *Model) StatusClassName() string {
+	if f.NoStatus {
+		return "Void"
+	}
+	return f.ClassName + "Status"
+}
+
+// AnnotationValues returns the values of an annotation taking an array of strings, e.g. {"a", "b"} in Java
+func (f *Model) AnnotationValues(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, strconv.Quote(value))
+	}
+	if f.Kotlin {
+		// Kotlin passes the value of an array annotation as variable arguments
+		return strings.Join(quoted, ", ")
+	}
+	return "{" + strings.Join(quoted, ", ") + "}"
+}
+
+const modelTemplate = `package {{ .Package }};
+
+{{if .Resource.API.Namespaced}}import io.fabric8.kubernetes.api.model.Namespaced;{{end}}
+import io.fabric8.kubernetes.client.CustomResource;
+{{if .Categories}}import io.fabric8.kubernetes.model.annotation.Categories;
+{{end -}}
+import io.fabric8.kubernetes.model.annotation.Group;
+import io.fabric8.kubernetes.model.annotation.Kind;
+{{if .HasPlural}}import io.fabric8.kubernetes.model.annotation.Plural;
+{{end -}}
+{{if .ShortNames}}import io.fabric8.kubernetes.model.annotation.ShortNames;
+{{end -}}
+import io.fabric8.kubernetes.model.annotation.Version;
+
+{{ .VersionAnnotation }}
+@Group("{{ .Resource.QualifiedGroup }}")
+@Kind("{{ .Resource.Kind }}")
+{{if .HasPlural}}@Plural("{{ .Resource.Plural }}")
+{{end -}}
+{{if .ShortNames}}@ShortNames({{ .AnnotationValues .ShortNames }})
+{{end -}}
+{{if .Categories}}@Categories({{ .AnnotationValues .Categories }})
+{{end -}}
+public class {{ .ClassName }} extends CustomResource<{{ .ClassName }}Spec, {{ .StatusClassName }}> {{if .Resource.API.Namespaced}}implements Namespaced {{end}}{}
+
+`
+
+const modelKotlinTemplate = `package {{ .Package }}
+
+{{if .Resource.API.Namespaced}}import io.fabric8.kubernetes.api.model.Namespaced
+{{end -}}
+import io.fabric8.kubernetes.client.CustomResource
+{{if .Categories}}import io.fabric8.kubernetes.model.annotation.Categories
+{{end -}}
+import io.fabric8.kubernetes.model.annotation.Group
+import io.fabric8.kubernetes.model.annotation.Kind
+{{if .HasPlural}}import io.fabric8.kubernetes.model.annotation.Plural
+{{end -}}
+{{if .ShortNames}}import io.fabric8.kubernetes.model.annotation.ShortNames
+{{end -}}
+import io.fabric8.kubernetes.model.annotation.Version
+
+{{ .VersionAnnotation }}
+@Group("{{ .Resource.QualifiedGroup }}")
+@Kind("{{ .Resource.Kind }}")
+{{if .HasPlural}}@Plural("{{ .Resource.Plural }}")
+{{end -}}
+{{if .ShortNames}}@ShortNames({{ .AnnotationValues .ShortNames }})
+{{end -}}
+{{if .Categories}}@Categories({{ .AnnotationValues .Categories }})
+{{end -}}
+class {{ .ClassName }} : CustomResource<{{ .ClassName }}Spec, {{ .StatusClassName }}>(){{if .Resource.API.Namespaced}}, Namespaced{{end}}
+`
@@ -19,7 +19,7 @@ import (
 
 	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
 
-	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/scaffolds/internal/templates/util"
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/templates/util"
 )
 
 var _ machinery.Template = &ModelSpec{}
@@ -32,6 +32,12 @@ type ModelSpec struct {
 
 	// Name of the operator used for the main file.
 	ClassName string
+
+	// Kotlin indicates that the source file is written in Kotlin instead of Java
+	Kotlin bool
+
+	// Properties are the fields of the class, left for the user to declare if empty
+	Properties Properties
 }
 
 func (f *ModelSpec) SetTemplateDefaults() error {
@@ -39,11 +45,25 @@ func (f *ModelSpec) SetTemplateDefaults() error {
 		return fmt.Errorf("invalid operator name")
 	}
 
+	if f.Kotlin {
+		if f.Path == "" {
+			f.Path = util.PrependKotlinPath(f.ClassName+"Spec.kt", util.AsPath(f.Package))
+		}
+		f.TemplateBody = modelSpecKotlinTemplate
+		if !f.Properties.IsEmpty() {
+			f.TemplateBody = modelSpecKotlinPropertiesTemplate
+		}
+		return nil
+	}
+
 	if f.Path == "" {
 		f.Path = util.PrependJavaPath(f.ClassName+"Spec.java", util.AsPath(f.Package))
 	}
 
 	f.TemplateBody = modelSpecTemplate
+	if !f.Properties.IsEmpty() {
+		f.TemplateBody = modelSpecPropertiesTemplate
+	}
 
 	return nil
 }
@@ -56,3 +76,29 @@ public class {{ .ClassName }}Spec {
     // Add Spec information here
 }
 `
+
+// A Kotlin data class needs at least one property, so the empty spec is a regular class until its properties are declared
+const modelSpecKotlinTemplate = `package {{ .Package }}
+
+class {{ .ClassName }}Spec {
+
+    // Add Spec information here
+}
+`
+
+const modelSpecPropertiesTemplate = `package {{ .Package }};
+{{ with .Properties.Imports false }}
+{{ range . }}import {{ . }};
+{{ end }}{{ end }}
+public class {{ .ClassName }}Spec {
+{{ .Properties.JavaBody }}}
+`
+
+const modelSpecKotlinPropertiesTemplate = `package {{ .Package }}
+{{ with .Properties.Imports true }}
+{{ range . }}import {{ . }}
+{{ end }}{{ end }}
+data class {{ .ClassName }}Spec(
+{{ .Properties.KotlinParameters }}){{ with .Properties.KotlinBody }} {
+{{ . }}}{{ end }}
+`
@@ -0,0 +1,103 @@
+// Copyright 2021 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/templates/util"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+var _ machinery.Template = &ModelStatus{}
+
+type ModelStatus struct {
+	machinery.TemplateMixin
+
+	// Package is the source files package
+	Package string
+
+	// Name of the operator used for the main file.
+	ClassName string
+
+	// Kotlin indicates that the source file is written in Kotlin instead of Java
+	Kotlin bool
+
+	// Properties are the fields of the class, left for the user to declare if empty
+	Properties Properties
+}
+
+func (f *ModelStatus) SetTemplateDefaults() error {
+	if f.ClassName == "" {
+		return fmt.Errorf("invalid operator name")
+	}
+
+	if f.Kotlin {
+		if f.Path == "" {
+			f.Path = util.PrependKotlinPath(f.ClassName+"Status.kt", util.AsPath(f.Package))
+		}
+		f.TemplateBody = modelStatusKotlinTemplate
+		if !f.Properties.IsEmpty() {
+			f.TemplateBody = modelStatusKotlinPropertiesTemplate
+		}
+		return nil
+	}
+
+	if f.Path == "" {
+		f.Path = util.PrependJavaPath(f.ClassName+"Status.java", util.AsPath(f.Package))
+	}
+
+	f.TemplateBody = modelStatusTemplate
+	if !f.Properties.IsEmpty() {
+		f.TemplateBody = modelStatusPropertiesTemplate
+	}
+
+	return nil
+}
+
+// TODO: pass in the name of the operator i.e. replace Memcached
+const modelStatusTemplate = `package {{ .Package }};
+
+public class {{ .ClassName }}Status {
+
+    // Add Status information here
+}
+`
+
+// A Kotlin data class needs at least one property, so the empty status is a regular class until its properties are declared
+const modelStatusKotlinTemplate = `package {{ .Package }}
+
+class {{ .ClassName }}Status {
+
+    // Add Status information here
+}
+`
+
+const modelStatusPropertiesTemplate = `package {{ .Package }};
+{{ with .Properties.Imports false }}
+{{ range . }}import {{ . }};
+{{ end }}{{ end }}
+public class {{ .ClassName }}Status {
+{{ .Properties.JavaBody }}}
+`
+
+const modelStatusKotlinPropertiesTemplate = `package {{ .Package }}
+{{ with .Properties.Imports true }}
+{{ range . }}import {{ . }}
+{{ end }}{{ end }}
+data class {{ .ClassName }}Status(
+{{ .Properties.KotlinParameters }}){{ with .Properties.KotlinBody }} {
+{{ . }}}{{ end }}
+`
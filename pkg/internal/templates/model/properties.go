@@ -0,0 +1,502 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/util"
+)
+
+// Scalar types of the fields of the generated classes
+const (
+	TypeString      = "string"
+	TypeInt         = "int"
+	TypeLong        = "long"
+	TypeFloat       = "float"
+	TypeDouble      = "double"
+	TypeBoolean     = "boolean"
+	TypeIntOrString = "int-or-string"
+	// TypeObject is any JSON value, used for the schemas which do not declare their structure
+	TypeObject = "object"
+)
+
+const (
+	intOrStringImport = "io.fabric8.kubernetes.api.model.IntOrString"
+	listImport        = "java.util.List"
+	mapImport         = "java.util.Map"
+
+	jsonPropertyImport            = "com.fasterxml.jackson.annotation.JsonProperty"
+	jsonPropertyDescriptionImport = "com.fasterxml.jackson.annotation.JsonPropertyDescription"
+	requiredImport                = "io.fabric8.generator.annotation.Required"
+	minImport                     = "io.fabric8.generator.annotation.Min"
+	maxImport                     = "io.fabric8.generator.annotation.Max"
+	patternImport                 = "io.fabric8.generator.annotation.Pattern"
+	printerColumnImport           = "io.fabric8.crd.generator.annotation.PrinterColumn"
+	specReplicasImport            = "io.fabric8.kubernetes.model.annotation.SpecReplicas"
+	statusReplicasImport          = "io.fabric8.kubernetes.model.annotation.StatusReplicas"
+)
+
+var (
+	javaTypes = map[string]string{
+		TypeString:      "String",
+		TypeInt:         "Integer",
+		TypeLong:        "Long",
+		TypeFloat:       "Float",
+		TypeDouble:      "Double",
+		TypeBoolean:     "Boolean",
+		TypeIntOrString: "IntOrString",
+		TypeObject:      "Object",
+	}
+
+	kotlinTypes = map[string]string{
+		TypeString:      "String",
+		TypeInt:         "Int",
+		TypeLong:        "Long",
+		TypeFloat:       "Float",
+		TypeDouble:      "Double",
+		TypeBoolean:     "Boolean",
+		TypeIntOrString: "IntOrString",
+		TypeObject:      "Any",
+	}
+
+	// kotlinKeywords are the hard keywords of Kotlin which are not Java keywords as well
+	kotlinKeywords = map[string]bool{
+		"as": true, "false": true, "fun": true, "in": true, "is": true, "null": true, "object": true,
+		"true": true, "typealias": true, "typeof": true, "val": true, "var": true, "when": true,
+	}
+
+	// finalObjectAccessors are the accessor suffixes of the final getters of java.lang.Object, which the getters of
+	// the fields would clash with, e.g. getClass()
+	finalObjectAccessors = map[string]bool{
+		"Class": true,
+	}
+)
+
+// Type is the type of a field of a generated class
+type Type struct {
+	// Name is a scalar type, e.g. TypeString, or the name of a generated class, if List and Map are false
+	Name string
+
+	// List and Map are true if the field holds a list of Elem values or a map of string keys to Elem values
+	List bool
+	Map  bool
+	Elem *Type
+}
+
+// IsScalar returns true if the type is one of the scalar types
+func (t Type) IsScalar() bool {
+	_, ok := javaTypes[t.Name]
+	return ok && !t.List && !t.Map
+}
+
+// Java returns the Java type
+func (t Type) Java() string {
+	return t.render(javaTypes, "List", "Map")
+}
+
+// Kotlin returns the Kotlin type
+func (t Type) Kotlin() string {
+	return t.render(kotlinTypes, "List", "Map")
+}
+
+func (t Type) render(scalars map[string]string, list, dict string) string {
+	switch {
+	case t.List:
+		return fmt.Sprintf("%s<%s>", list, t.Elem.render(scalars, list, dict))
+	case t.Map:
+		return fmt.Sprintf("%s<String, %s>", dict, t.Elem.render(scalars, list, dict))
+	}
+	if name, ok := scalars[t.Name]; ok {
+		return name
+	}
+	return t.Name
+}
+
+// imports adds the imports the type requires to the set. Lists and maps need no import in Kotlin.
+func (t Type) imports(set map[string]bool, kotlin bool) {
+	switch {
+	case t.List:
+		if !kotlin {
+			set[listImport] = true
+		}
+		t.Elem.imports(set, kotlin)
+	case t.Map:
+		if !kotlin {
+			set[mapImport] = true
+		}
+		t.Elem.imports(set, kotlin)
+	case t.Name == TypeIntOrString:
+		set[intOrStringImport] = true
+	}
+}
+
+// Field is a property of a generated class
+type Field struct {
+	// Name is the name of the property in the custom resource
+	Name string
+
+	Type        Type
+	Description string
+
+	// Validations of the property, generated as CRD generator annotations
+	Required bool
+	Minimum  *float64
+	Maximum  *float64
+	Pattern  string
+
+	// PrinterColumn is the name of the column printing the property with kubectl get, if any
+	PrinterColumn string
+
+	// SpecReplicas and StatusReplicas mark the property as the desired or the actual replicas of the scale
+	// subresource
+	SpecReplicas   bool
+	StatusReplicas bool
+}
+
+// VarName returns the name of the Java or Kotlin field holding the property
+func (f Field) VarName() string {
+	var sb strings.Builder
+	upper := false
+	for _, r := range f.Name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+			upper = sb.Len() > 0
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		sb.WriteRune(r)
+	}
+
+	name := sb.String()
+	switch {
+	case name == "":
+		name = "value"
+	case unicode.IsDigit(rune(name[0])):
+		name = "_" + name
+	}
+	if util.IsReservedWord(name) || kotlinKeywords[name] || finalObjectAccessors[accessor(name)] {
+		name += "_"
+	}
+	return name
+}
+
+// Accessor returns the suffix of the getter and setter names of the field
+func (f Field) Accessor() string {
+	return accessor(f.VarName())
+}
+
+func accessor(name string) string {
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// Annotations returns the Java annotations of the field
+func (f Field) Annotations() []string {
+	return f.annotations(javaString)
+}
+
+func (f Field) annotations(javaString func(string) string) []string {
+	var annotations []string
+	if f.VarName() != f.Name {
+		annotations = append(annotations, fmt.Sprintf("@JsonProperty(%s)", javaString(f.Name)))
+	}
+	if f.Description != "" {
+		annotations = append(annotations, fmt.Sprintf("@JsonPropertyDescription(%s)", javaString(f.Description)))
+	}
+	if f.Required {
+		annotations = append(annotations, "@Required")
+	}
+	if f.Minimum != nil {
+		annotations = append(annotations, fmt.Sprintf("@Min(%s)", javaDouble(*f.Minimum)))
+	}
+	if f.Maximum != nil {
+		annotations = append(annotations, fmt.Sprintf("@Max(%s)", javaDouble(*f.Maximum)))
+	}
+	if f.Pattern != "" {
+		annotations = append(annotations, fmt.Sprintf("@Pattern(%s)", javaString(f.Pattern)))
+	}
+	if f.PrinterColumn != "" {
+		annotations = append(annotations, fmt.Sprintf("@PrinterColumn(name = %s)", javaString(f.PrinterColumn)))
+	}
+	if f.SpecReplicas {
+		annotations = append(annotations, "@SpecReplicas")
+	}
+	if f.StatusReplicas {
+		annotations = append(annotations, "@StatusReplicas")
+	}
+	return annotations
+}
+
+// imports adds the imports the field requires to the set
+func (f Field) imports(set map[string]bool, kotlin bool) {
+	f.Type.imports(set, kotlin)
+	if f.VarName() != f.Name {
+		set[jsonPropertyImport] = true
+	}
+	if f.Description != "" {
+		set[jsonPropertyDescriptionImport] = true
+	}
+	if f.Required {
+		set[requiredImport] = true
+	}
+	if f.Minimum != nil {
+		set[minImport] = true
+	}
+	if f.Maximum != nil {
+		set[maxImport] = true
+	}
+	if f.Pattern != "" {
+		set[patternImport] = true
+	}
+	if f.PrinterColumn != "" {
+		set[printerColumnImport] = true
+	}
+	if f.SpecReplicas {
+		set[specReplicasImport] = true
+	}
+	if f.StatusReplicas {
+		set[statusReplicasImport] = true
+	}
+}
+
+// Class is a class nested in a generated spec or status class, either holding fields or enumerating values
+type Class struct {
+	Name   string
+	Fields []Field
+
+	// EnumValues are the values of the enum the class represents
+	EnumValues []string
+}
+
+// IsEnum returns true if the class is an enum
+func (c Class) IsEnum() bool {
+	return len(c.EnumValues) != 0
+}
+
+// EnumConstants returns the names of the enum constants, in the order of the values
+func (c Class) EnumConstants() []EnumConstant {
+	constants := make([]EnumConstant, 0, len(c.EnumValues))
+	for _, value := range c.EnumValues {
+		constants = append(constants, EnumConstant{Name: enumConstantName(value), Value: value})
+	}
+	return constants
+}
+
+// EnumConstant is a constant of a generated enum, serialized as its value
+type EnumConstant struct {
+	Name  string
+	Value string
+}
+
+// JSONProperty returns the Java annotation serializing the constant as its value
+func (c EnumConstant) JSONProperty() string {
+	return fmt.Sprintf("@JsonProperty(%s)", javaString(c.Value))
+}
+
+// enumConstantName converts an enum value such as IfNotPresent to a constant name such as IF_NOT_PRESENT
+func enumConstantName(value string) string {
+	var sb strings.Builder
+	runes := []rune(value)
+	for i, r := range runes {
+		switch {
+		case unicode.IsUpper(r):
+			if i > 0 && (unicode.IsLower(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1])) {
+				sb.WriteRune('_')
+			}
+			sb.WriteRune(r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			sb.WriteRune(unicode.ToUpper(r))
+		default:
+			sb.WriteRune('_')
+		}
+	}
+
+	name := sb.String()
+	if name == "" || unicode.IsDigit(rune(name[0])) {
+		name = "_" + name
+	}
+	return name
+}
+
+// Properties are the fields of a generated spec or status class, along with the classes nested in it
+type Properties struct {
+	Fields  []Field
+	Classes []Class
+}
+
+// IsEmpty returns true if there are no fields
+func (p Properties) IsEmpty() bool {
+	return len(p.Fields) == 0
+}
+
+// Imports returns the sorted imports the fields and the nested classes require
+func (p Properties) Imports(kotlin bool) []string {
+	set := map[string]bool{}
+	for _, field := range p.Fields {
+		field.imports(set, kotlin)
+	}
+	for _, class := range p.Classes {
+		if class.IsEnum() {
+			set[jsonPropertyImport] = true
+		}
+		for _, field := range class.Fields {
+			field.imports(set, kotlin)
+		}
+	}
+
+	imports := make([]string, 0, len(set))
+	for i := range set {
+		imports = append(imports, i)
+	}
+	sort.Strings(imports)
+	return imports
+}
+
+// Schema holds the properties of the spec and status classes of a custom resource
+type Schema struct {
+	Spec   Properties
+	Status Properties
+}
+
+// javaString returns s as a Java string literal
+func javaString(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"', '\\':
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\t':
+			sb.WriteString(`\t`)
+		default:
+			if unicode.IsControl(r) {
+				fmt.Fprintf(&sb, `\u%04x`, r)
+				continue
+			}
+			sb.WriteRune(r)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+// kotlinString returns s as a Kotlin string literal, which would interpolate the unescaped dollar signs
+func kotlinString(s string) string {
+	return strings.ReplaceAll(javaString(s), "$", `\$`)
+}
+
+// javaDouble returns f as a Java and Kotlin double literal
+func javaDouble(f float64) string {
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	if !strings.Contains(s, ".") {
+		s += ".0"
+	}
+	return s
+}
+
+// JavaBody returns the fields, accessors and nested classes of a Java class holding the properties
+func (p Properties) JavaBody() string {
+	var sb strings.Builder
+	writeJavaMembers(&sb, p.Fields, "    ")
+	for _, class := range p.Classes {
+		sb.WriteString("\n")
+		if class.IsEnum() {
+			fmt.Fprintf(&sb, "    public enum %s {\n", class.Name)
+			constants := class.EnumConstants()
+			for i, constant := range constants {
+				fmt.Fprintf(&sb, "        %s\n        %s", constant.JSONProperty(), constant.Name)
+				if i < len(constants)-1 {
+					sb.WriteString(",")
+				}
+				sb.WriteString("\n")
+			}
+			sb.WriteString("    }\n")
+			continue
+		}
+
+		fmt.Fprintf(&sb, "    public static class %s {\n", class.Name)
+		writeJavaMembers(&sb, class.Fields, "        ")
+		sb.WriteString("    }\n")
+	}
+	return sb.String()
+}
+
+func writeJavaMembers(sb *strings.Builder, fields []Field, indent string) {
+	for _, field := range fields {
+		sb.WriteString("\n")
+		for _, annotation := range field.Annotations() {
+			fmt.Fprintf(sb, "%s%s\n", indent, annotation)
+		}
+		fmt.Fprintf(sb, "%sprivate %s %s;\n", indent, field.Type.Java(), field.VarName())
+	}
+	for _, field := range fields {
+		javaType, name := field.Type.Java(), field.VarName()
+		fmt.Fprintf(sb, "\n%[1]spublic %[2]s get%[3]s() {\n%[1]s    return %[4]s;\n%[1]s}\n",
+			indent, javaType, field.Accessor(), name)
+		fmt.Fprintf(sb, "\n%[1]spublic void set%[3]s(%[2]s %[4]s) {\n%[1]s    this.%[4]s = %[4]s;\n%[1]s}\n",
+			indent, javaType, field.Accessor(), name)
+	}
+}
+
+// KotlinParameters returns the constructor parameters of a Kotlin data class holding the properties
+func (p Properties) KotlinParameters() string {
+	var sb strings.Builder
+	writeKotlinParameters(&sb, p.Fields, "    ")
+	return sb.String()
+}
+
+// KotlinBody returns the classes nested in a Kotlin data class holding the properties
+func (p Properties) KotlinBody() string {
+	var sb strings.Builder
+	for i, class := range p.Classes {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		if class.IsEnum() {
+			fmt.Fprintf(&sb, "    enum class %s {\n", class.Name)
+			for _, constant := range class.EnumConstants() {
+				fmt.Fprintf(&sb, "        @JsonProperty(%s)\n        %s,\n", kotlinString(constant.Value), constant.Name)
+			}
+			sb.WriteString("    }\n")
+			continue
+		}
+
+		fmt.Fprintf(&sb, "    data class %s(\n", class.Name)
+		writeKotlinParameters(&sb, class.Fields, "        ")
+		sb.WriteString("    )\n")
+	}
+	return sb.String()
+}
+
+func writeKotlinParameters(sb *strings.Builder, fields []Field, indent string) {
+	for _, field := range fields {
+		for _, annotation := range field.annotations(kotlinString) {
+			// Annotate the backing field, which Jackson and the CRD generator inspect
+			fmt.Fprintf(sb, "%s@field:%s\n", indent, annotation[1:])
+		}
+		fmt.Fprintf(sb, "%svar %s: %s? = null,\n", indent, field.VarName(), field.Type.Kotlin())
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Properties", func() {
+	It("renders the Java and Kotlin types", func() {
+		t := Type{Map: true, Elem: &Type{List: true, Elem: &Type{Name: TypeObject}}}
+		Expect(t.Java()).To(Equal("Map<String, List<Object>>"))
+		Expect(t.Kotlin()).To(Equal("Map<String, List<Any>>"))
+	})
+
+	It("derives legal variable names from the property names", func() {
+		Expect(Field{Name: "replica-count"}.VarName()).To(Equal("replicaCount"))
+		Expect(Field{Name: "class"}.VarName()).To(Equal("class_"))
+		Expect(Field{Name: "object"}.VarName()).To(Equal("object_"))
+		Expect(Field{Name: "3d"}.VarName()).To(Equal("_3d"))
+		Expect(Field{Name: "null"}.VarName()).To(Equal("null_"))
+		Expect(Field{Name: "size"}.Accessor()).To(Equal("Size"))
+	})
+
+	It("keeps the getters from clashing with the final getClass() of Object", func() {
+		for _, name := range []string{"class", "Class"} {
+			Expect(Field{Name: name}.Accessor()).To(Equal("Class_"), name)
+		}
+		Expect(Field{Name: "classes"}.Accessor()).To(Equal("Classes"))
+	})
+
+	It("names the enum constants after the values", func() {
+		class := Class{Name: "Policy", EnumValues: []string{"IfNotPresent", "always", "HTTPServer", "2x"}}
+		var names []string
+		for _, constant := range class.EnumConstants() {
+			names = append(names, constant.Name)
+		}
+		Expect(names).To(Equal([]string{"IF_NOT_PRESENT", "ALWAYS", "HTTP_SERVER", "_2X"}))
+	})
+
+	It("escapes the string literals", func() {
+		Expect(javaString("a \"b\" $c\n")).To(Equal(`"a \"b\" $c\n"`))
+		Expect(kotlinString("a \"b\" $c\n")).To(Equal(`"a \"b\" \$c\n"`))
+	})
+
+	It("collects the imports", func() {
+		properties := Properties{
+			Fields: []Field{
+				{Name: "ports", Type: Type{List: true, Elem: &Type{Name: TypeIntOrString}}, Required: true},
+			},
+			Classes: []Class{{Name: "Policy", EnumValues: []string{"Always"}}},
+		}
+		Expect(properties.Imports(false)).To(Equal([]string{
+			jsonPropertyImport, requiredImport, intOrStringImport, listImport,
+		}))
+		Expect(properties.Imports(true)).To(Equal([]string{jsonPropertyImport, requiredImport, intOrStringImport}))
+	})
+
+	It("annotates the printed and the replicas fields", func() {
+		properties := Properties{Fields: []Field{
+			{Name: "replicas", Type: Type{Name: TypeInt}, PrinterColumn: "Desired \"Replicas\"", SpecReplicas: true},
+		}}
+		Expect(properties.Imports(false)).To(Equal([]string{printerColumnImport, specReplicasImport}))
+		Expect(properties.JavaBody()).To(HavePrefix(
+			"\n    @PrinterColumn(name = \"Desired \\\"Replicas\\\"\")\n    @SpecReplicas\n    private Integer replicas;\n"))
+		Expect(properties.KotlinParameters()).To(Equal(
+			"    @field:PrinterColumn(name = \"Desired \\\"Replicas\\\"\")\n    @field:SpecReplicas\n    var replicas: Int? = null,\n"))
+	})
+})
@@ -22,6 +22,7 @@ import (
 const (
 	filePathSep  = string(filepath.Separator)
 	javaPath     = "src" + filePathSep + "main" + filePathSep + "java"
+	kotlinPath   = "src" + filePathSep + "main" + filePathSep + "kotlin"
 	resourcePath = "src" + filePathSep + "main" + filePathSep + "resources"
 )
 
@@ -29,6 +30,10 @@ func PrependJavaPath(filename string, pkg string) string {
 	return javaPath + filePathSep + pkg + filePathSep + filename
 }
 
+func PrependKotlinPath(filename string, pkg string) string {
+	return kotlinPath + filePathSep + pkg + filePathSep + filename
+}
+
 func PrependResourcePath(filename string) string {
 	return resourcePath + filePathSep + filename
 }
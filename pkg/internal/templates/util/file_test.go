@@ -32,6 +32,13 @@ var _ = Describe("file", func() {
 		})
 	})
 
+	Describe("PrependKotlinPath", func() {
+		It("should prepend the configured kotlin path to the given file", func() {
+			Expect(PrependKotlinPath("MyReconciler.kt", "com/example")).
+				To(Equal("src/main/kotlin/com/example/MyReconciler.kt"))
+		})
+	})
+
 	Describe("PrependResourcePath", func() {
 		It("should prepend the configured resource path to the given file", func() {
 			Expect("src/main/resources/application.properties",
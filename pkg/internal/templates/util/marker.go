@@ -0,0 +1,28 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+// markerCommentFile is a file name whose extension uses the "//" line comments of Java and Kotlin
+// sources, as machinery.NewMarkerFor only accepts the extensions of the Go and YAML files.
+const markerCommentFile = "marker.go"
+
+// NewJavaMarker creates a scaffolding marker for a Java or Kotlin source file
+func NewJavaMarker(value string) machinery.Marker {
+	return machinery.NewMarkerFor(markerCommentFile, value)
+}
@@ -0,0 +1,30 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("marker", func() {
+	Describe("NewJavaMarker", func() {
+		It("should use a Java line comment", func() {
+			marker := NewJavaMarker("reconcilers")
+			Expect(marker.String()).To(Equal("//+kubebuilder:scaffold:reconcilers"))
+			Expect(marker.EqualsLine("    // +kubebuilder:scaffold:reconcilers")).To(BeTrue())
+		})
+	})
+})
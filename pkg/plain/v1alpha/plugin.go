@@ -0,0 +1,74 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	v3 "sigs.k8s.io/kubebuilder/v3/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v3/pkg/model/stage"
+	"sigs.k8s.io/kubebuilder/v3/pkg/plugin"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/josdk"
+	"github.com/operator-framework/java-operator-plugins/pkg/plain/v1alpha/scaffolds"
+)
+
+const pluginName = "plain.javaoperatorsdk.io"
+
+var (
+	supportedProjectVersions = []config.Version{v3.Version}
+	pluginVersion            = plugin.Version{Number: 1, Stage: stage.Alpha}
+)
+
+var (
+	_ plugin.Init      = Plugin{}
+	_ plugin.CreateAPI = Plugin{}
+)
+
+// variant describes the plugin to the subcommands it shares with the other java-operator-sdk plugins
+var variant = josdk.Variant{
+	Key:  plugin.KeyFor(Plugin{}),
+	Name: "plain",
+	InitDescription: `Initialize a new project based on the plain java-operator-sdk, without any framework.
+
+Writes the following files:
+- a pom.xml file to build the project with Maven and its image with Jib
+- a main class building the Operator and registering the reconcilers
+- a Makefile to build, run and install the operator
+`,
+	CreateAPIDescription: `Writes the custom resource, spec and status classes, and a reconciler
+registered with the Operator built by the main class.
+`,
+	Templates: scaffolds.Templates{},
+}
+
+// Plugin implements the plugin.Init and plugin.CreateAPI interfaces
+type Plugin struct{}
+
+// Name returns the name of the plugin
+func (Plugin) Name() string { return pluginName }
+
+// Version returns the version of the plugin
+func (Plugin) Version() plugin.Version { return pluginVersion }
+
+// SupportedProjectVersions returns an array with all project versions supported by the plugin
+func (Plugin) SupportedProjectVersions() []config.Version { return supportedProjectVersions }
+
+// GetInitSubcommand will return the subcommand which is responsible for initializing and common scaffolding
+func (Plugin) GetInitSubcommand() plugin.InitSubcommand { return josdk.NewInitSubcommand(variant) }
+
+// GetCreateAPISubcommand will return the subcommand which is responsible for scaffolding apis
+func (Plugin) GetCreateAPISubcommand() plugin.CreateAPISubcommand {
+	return josdk.NewCreateAPISubcommand(variant)
+}
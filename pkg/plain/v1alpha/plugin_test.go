@@ -0,0 +1,45 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	v3 "sigs.k8s.io/kubebuilder/v3/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v3/pkg/model/stage"
+	"sigs.k8s.io/kubebuilder/v3/pkg/plugin"
+)
+
+var _ = Describe("Plugin", func() {
+	testPlugin := Plugin{}
+
+	It("should return the plugin name", func() {
+		Expect(testPlugin.Name()).To(Equal("plain.javaoperatorsdk.io"))
+	})
+
+	It("should return the plugin version", func() {
+		Expect(testPlugin.Version()).To(Equal(plugin.Version{Number: 1, Stage: stage.Alpha}))
+	})
+
+	It("should support project version 3", func() {
+		Expect(testPlugin.SupportedProjectVersions()).To(Equal([]config.Version{v3.Version}))
+	})
+
+	It("should return the init and create api subcommands", func() {
+		Expect(testPlugin.GetInitSubcommand()).NotTo(BeNil())
+		Expect(testPlugin.GetCreateAPISubcommand()).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,99 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package templates
+
+import (
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+var _ machinery.Template = &Makefile{}
+
+// Makefile scaffolds the Makefile
+type Makefile struct {
+	machinery.TemplateMixin
+
+	// Image is controller manager image name
+	Image string
+
+	// Package is the source files package
+	Package string
+
+	// Name of the operator used for the main file.
+	OperatorName string
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *Makefile) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = "Makefile"
+	}
+
+	f.TemplateBody = makefileTemplate
+
+	f.IfExistsAction = machinery.Error
+
+	if f.Image == "" {
+		f.Image = "controller:latest"
+	}
+
+	f.OperatorName = operatorClassName(f.OperatorName)
+
+	return nil
+}
+
+const makefileTemplate = `
+# Image URL to use all building/pushing image targets
+IMG ?= {{ .Image }}
+
+all: docker-build
+
+##@ General
+
+# The help target prints out all targets with their descriptions organized
+# beneath their categories. The categories are represented by '##@' and the
+# target descriptions by '##'. The awk commands is responsible for reading the
+# entire set of makefiles included in this invocation, looking for lines of the
+# file as xyz: ## something, and then pretty-format the target and help. Then,
+# if there's a line with ##@ something, that gets pretty-printed as a category.
+# More info on the usage of ANSI control characters for terminal formatting:
+# https://en.wikipedia.org/wiki/ANSI_escape_code#SGR_parameters
+# More info on the awk command:
+# http://linuxcommand.org/lc3_adv_awk.php
+
+help: ## Display this help.
+	@awk 'BEGIN {FS = ":.*##"; printf "\nUsage:\n  make \033[36m<target>\033[0m\n"} /^[a-zA-Z_0-9-]+:.*?##/ { printf "  \033[36m%-15s\033[0m %s\n", $$1, $$2 } /^##@/ { printf "\n\033[1m%s\033[0m\n", substr($$0, 5) } ' $(MAKEFILE_LIST)
+
+##@ Build
+
+build: ## Build the operator and generate the CRDs.
+	mvn package
+
+run: ## Run the operator against the K8s cluster specified in ~/.kube/config.
+	mvn compile exec:java -Dexec.mainClass={{ .Package }}.{{ .OperatorName }}
+
+docker-build: ## Build docker image with the manager.
+	mvn compile jib:dockerBuild -Djib.to.image=${IMG}
+
+docker-push: ## Push docker image with the manager.
+	mvn compile jib:build -Djib.to.image=${IMG}
+
+##@ Deployment
+
+install: ## Install the CRDs generated by the build into the K8s cluster specified in ~/.kube/config.
+	@$(foreach file, $(wildcard target/classes/META-INF/fabric8/*-v1.yml), kubectl apply -f $(file);)
+
+uninstall: ## Uninstall the CRDs generated by the build from the K8s cluster specified in ~/.kube/config.
+	@$(foreach file, $(wildcard target/classes/META-INF/fabric8/*-v1.yml), kubectl delete -f $(file);)
+`
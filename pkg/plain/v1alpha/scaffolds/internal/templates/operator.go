@@ -0,0 +1,128 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package templates
+
+import (
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/templates/util"
+)
+
+const reconcilersMarker = "reconcilers"
+
+var (
+	_ machinery.Template = &OperatorFile{}
+	_ machinery.Inserter = &OperatorUpdater{}
+)
+
+// operatorClassName returns the name of the main class, which ends with Operator
+func operatorClassName(operatorName string) string {
+	return strings.TrimSuffix(operatorName, "Operator") + "Operator"
+}
+
+// operatorPath returns the path of the main class
+func operatorPath(pkg, operatorName string) string {
+	return util.PrependJavaPath(operatorClassName(operatorName)+".java", util.AsPath(pkg))
+}
+
+// OperatorFile scaffolds the main class building the Operator and registering the reconcilers
+type OperatorFile struct {
+	machinery.TemplateMixin
+
+	// Package is the source files package
+	Package string
+
+	// Name of the operator used for the main file.
+	OperatorName string
+
+	// ReconcilersMarker is where create api registers the reconcilers
+	ReconcilersMarker machinery.Marker
+}
+
+func (f *OperatorFile) SetTemplateDefaults() error {
+	if f.OperatorName == "" {
+		return fmt.Errorf("invalid operator name")
+	}
+
+	f.OperatorName = operatorClassName(f.OperatorName)
+
+	if f.Path == "" {
+		f.Path = operatorPath(f.Package, f.OperatorName)
+	}
+
+	f.ReconcilersMarker = util.NewJavaMarker(reconcilersMarker)
+
+	f.TemplateBody = operatorTemplate
+
+	return nil
+}
+
+const operatorTemplate = `package {{ .Package }};
+
+import io.fabric8.kubernetes.client.DefaultKubernetesClient;
+import io.fabric8.kubernetes.client.KubernetesClient;
+import io.javaoperatorsdk.operator.Operator;
+import io.javaoperatorsdk.operator.config.runtime.DefaultConfigurationService;
+
+public class {{ .OperatorName }} {
+
+  public static void main(String[] args) {
+    KubernetesClient client = new DefaultKubernetesClient();
+    Operator operator = new Operator(client, DefaultConfigurationService.instance());
+    {{ .ReconcilersMarker }}
+    operator.installShutdownHook();
+    operator.start();
+  }
+}
+`
+
+// OperatorUpdater registers the reconciler of a new API with the Operator built by the main class
+type OperatorUpdater struct {
+	machinery.InserterMixin
+
+	// Package is the source files package
+	Package string
+
+	// Name of the operator used for the main file.
+	OperatorName string
+
+	// ClassName is the name of the custom resource class the reconciler handles
+	ClassName string
+}
+
+// GetPath implements machinery.Builder
+func (f *OperatorUpdater) GetPath() string {
+	return operatorPath(f.Package, f.OperatorName)
+}
+
+// GetMarkers implements machinery.Inserter
+func (f *OperatorUpdater) GetMarkers() []machinery.Marker {
+	return []machinery.Marker{util.NewJavaMarker(reconcilersMarker)}
+}
+
+const registerReconcilerCodeFragment = `    operator.register(new %sReconciler(client));
+`
+
+// GetCodeFragments implements machinery.Inserter
+func (f *OperatorUpdater) GetCodeFragments() machinery.CodeFragmentsMap {
+	return machinery.CodeFragmentsMap{
+		util.NewJavaMarker(reconcilersMarker): {
+			fmt.Sprintf(registerReconcilerCodeFragment, f.ClassName),
+		},
+	}
+}
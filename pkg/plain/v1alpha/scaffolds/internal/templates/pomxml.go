@@ -0,0 +1,123 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package templates
+
+import (
+	"errors"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+var _ machinery.Template = &PomXmlFile{}
+
+// PomXmlFile scaffolds the pom.xml of a plain java-operator-sdk project, building its image with Jib
+type PomXmlFile struct {
+	machinery.TemplateMixin
+
+	// Package is the source files package
+	Package         string
+	ProjectName     string
+	OperatorVersion string
+
+	// Name of the operator used for the main file.
+	OperatorName string
+
+	// OperatorSDKVersion is the version of the java-operator-sdk used to build the project
+	OperatorSDKVersion string
+}
+
+func (f *PomXmlFile) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = "pom.xml"
+	}
+
+	f.OperatorName = operatorClassName(f.OperatorName)
+
+	f.TemplateBody = pomxmlTemplate
+
+	if f.OperatorSDKVersion == "" {
+		return errors.New("java-operator-sdk version is required in scaffold")
+	}
+
+	return nil
+}
+
+const pomxmlTemplate = `<?xml version="1.0" encoding="UTF-8"?>
+<project xmlns="http://maven.apache.org/POM/4.0.0"
+  xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"
+  xsi:schemaLocation="http://maven.apache.org/POM/4.0.0 http://maven.apache.org/xsd/maven-4.0.0.xsd">
+  <modelVersion>4.0.0</modelVersion>
+  <groupId>{{ .Package }}</groupId>
+  <artifactId>{{ .ProjectName }}</artifactId>
+  <name>{{ .ProjectName }}</name>
+  <version>{{ .OperatorVersion }}-SNAPSHOT</version>
+  <packaging>jar</packaging>
+  <properties>
+    <compiler-plugin.version>3.8.1</compiler-plugin.version>
+    <maven.compiler.parameters>true</maven.compiler.parameters>
+    <maven.compiler.source>11</maven.compiler.source>
+    <maven.compiler.target>11</maven.compiler.target>
+    <project.build.sourceEncoding>UTF-8</project.build.sourceEncoding>
+    <project.reporting.outputEncoding>UTF-8</project.reporting.outputEncoding>
+    <josdk.version>{{ .OperatorSDKVersion }}</josdk.version>
+    <fabric8-client.version>5.12.2</fabric8-client.version>
+    <slf4j.version>1.7.36</slf4j.version>
+    <jib-maven-plugin.version>3.2.1</jib-maven-plugin.version>
+  </properties>
+
+  <dependencies>
+    <dependency>
+      <groupId>io.javaoperatorsdk</groupId>
+      <artifactId>operator-framework</artifactId>
+      <version>${josdk.version}</version>
+    </dependency>
+    <dependency>
+      <!-- Generates the CRDs under target/classes/META-INF/fabric8 when compiling the models -->
+      <groupId>io.fabric8</groupId>
+      <artifactId>crd-generator-apt</artifactId>
+      <version>${fabric8-client.version}</version>
+      <scope>provided</scope>
+    </dependency>
+    <dependency>
+      <groupId>org.slf4j</groupId>
+      <artifactId>slf4j-simple</artifactId>
+      <version>${slf4j.version}</version>
+    </dependency>
+  </dependencies>
+
+  <build>
+    <plugins>
+      <plugin>
+        <artifactId>maven-compiler-plugin</artifactId>
+        <version>${compiler-plugin.version}</version>
+      </plugin>
+      <plugin>
+        <groupId>com.google.cloud.tools</groupId>
+        <artifactId>jib-maven-plugin</artifactId>
+        <version>${jib-maven-plugin.version}</version>
+        <configuration>
+          <from>
+            <image>eclipse-temurin:11-jre</image>
+          </from>
+          <container>
+            <mainClass>{{ .Package }}.{{ .OperatorName }}</mainClass>
+          </container>
+        </configuration>
+      </plugin>
+    </plugins>
+  </build>
+
+</project>
+`
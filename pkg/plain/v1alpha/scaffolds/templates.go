@@ -0,0 +1,77 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffolds
+
+import (
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/josdk"
+	josdktemplates "github.com/operator-framework/java-operator-plugins/pkg/internal/josdk/templates"
+	"github.com/operator-framework/java-operator-plugins/pkg/plain/v1alpha/scaffolds/internal/templates"
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/util"
+)
+
+const (
+	// DefaultOperatorSDKVersion is the java-operator-sdk version new projects are built with
+	DefaultOperatorSDKVersion = "2.1.4"
+)
+
+var _ josdk.Templates = Templates{}
+
+// Templates supplies the files of the projects built on the plain java-operator-sdk, without any framework
+type Templates struct{}
+
+// NewPluginConfig implements josdk.Templates
+func (Templates) NewPluginConfig(javaPackage string) josdk.PluginConfig {
+	return josdk.PluginConfig{
+		Package:            javaPackage,
+		OperatorSDKVersion: DefaultOperatorSDKVersion,
+	}
+}
+
+// Init implements josdk.Templates
+func (Templates) Init(cfg config.Config, pluginConfig josdk.PluginConfig) []machinery.Builder {
+	operatorName := util.ToClassname(cfg.GetProjectName())
+	return []machinery.Builder{
+		&templates.PomXmlFile{
+			Package:            pluginConfig.Package,
+			ProjectName:        cfg.GetProjectName(),
+			OperatorName:       operatorName,
+			OperatorVersion:    "0.0.1",
+			OperatorSDKVersion: pluginConfig.OperatorSDKVersion,
+		},
+		&templates.OperatorFile{
+			Package:      pluginConfig.Package,
+			OperatorName: operatorName,
+		},
+		&templates.Makefile{
+			Package:      pluginConfig.Package,
+			OperatorName: operatorName,
+		},
+	}
+}
+
+// API implements josdk.Templates, registering the reconciler with the Operator built by the main class
+func (Templates) API(cfg config.Config, pluginConfig josdk.PluginConfig, className string) []machinery.Builder {
+	return []machinery.Builder{
+		&josdktemplates.Controller{Package: pluginConfig.Package, ClassName: className},
+		&templates.OperatorUpdater{
+			Package:      pluginConfig.Package,
+			OperatorName: util.ToClassname(cfg.GetProjectName()),
+			ClassName:    className,
+		},
+	}
+}
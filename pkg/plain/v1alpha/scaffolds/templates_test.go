@@ -0,0 +1,97 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffolds
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	v3 "sigs.k8s.io/kubebuilder/v3/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v3/pkg/model/resource"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/josdk"
+)
+
+var _ = Describe("plain java-operator-sdk scaffolders", func() {
+	const operatorFile = "src/main/java/com/example/MemcachedOperator.java"
+
+	var (
+		fs           machinery.Filesystem
+		cfg          config.Config
+		pluginConfig josdk.PluginConfig
+	)
+
+	readFile := func(path string) string {
+		contents, err := afero.ReadFile(fs.FS, path)
+		Expect(err).NotTo(HaveOccurred())
+		return string(contents)
+	}
+
+	createAPI := func(kind string) {
+		res := resource.Resource{
+			GVK:    resource.GVK{Group: "cache", Domain: "example.com", Version: "v1", Kind: kind},
+			Plural: resource.RegularPlural(kind),
+			API:    &resource.API{CRDVersion: "v1", Namespaced: true},
+		}
+		scaffolder := josdk.NewCreateAPIScaffolder(cfg, pluginConfig, res, Templates{})
+		scaffolder.InjectFS(fs)
+		Expect(scaffolder.Scaffold()).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		cfg = v3.New()
+		Expect(cfg.SetDomain("example.com")).To(Succeed())
+		Expect(cfg.SetProjectName("memcached-operator")).To(Succeed())
+		pluginConfig = josdk.PluginConfig{Package: "com.example", OperatorSDKVersion: DefaultOperatorSDKVersion}
+
+		scaffolder := josdk.NewInitScaffolder(cfg, pluginConfig, Templates{})
+		scaffolder.InjectFS(fs)
+		Expect(scaffolder.Scaffold()).To(Succeed())
+	})
+
+	It("sets up new projects with the default version", func() {
+		Expect(Templates{}.NewPluginConfig("com.example")).To(Equal(pluginConfig))
+	})
+
+	It("scaffolds a project without framework", func() {
+		pom := readFile("pom.xml")
+		Expect(pom).To(ContainSubstring("<josdk.version>" + DefaultOperatorSDKVersion + "</josdk.version>"))
+		Expect(pom).To(ContainSubstring("<artifactId>jib-maven-plugin</artifactId>"))
+		Expect(pom).To(ContainSubstring("<mainClass>com.example.MemcachedOperator</mainClass>"))
+
+		Expect(readFile(operatorFile)).To(ContainSubstring(
+			"    Operator operator = new Operator(client, DefaultConfigurationService.instance());\n" +
+				"    //+kubebuilder:scaffold:reconcilers\n" +
+				"    operator.installShutdownHook();\n"))
+		Expect(readFile("Makefile")).To(ContainSubstring("-Dexec.mainClass=com.example.MemcachedOperator"))
+	})
+
+	It("registers each reconciler with the operator once", func() {
+		createAPI("Memcached")
+		createAPI("Memcached")
+		createAPI("Redis")
+
+		Expect(readFile(operatorFile)).To(ContainSubstring(
+			"    operator.register(new MemcachedReconciler(client));\n" +
+				"    operator.register(new RedisReconciler(client));\n" +
+				"    //+kubebuilder:scaffold:reconcilers\n"))
+		Expect(readFile("src/main/java/com/example/MemcachedReconciler.java")).To(ContainSubstring(
+			"@ControllerConfiguration\npublic class MemcachedReconciler implements Reconciler<Memcached> {"))
+		Expect(afero.Exists(fs.FS, "src/main/java/com/example/MemcachedSpec.java")).To(BeTrue())
+	})
+})
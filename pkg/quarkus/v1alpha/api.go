@@ -15,37 +15,90 @@
 package v1
 
 import (
-	"bufio"
 	"errors"
 	"fmt"
-	"os"
-	"path/filepath"
+	"reflect"
 	"strings"
 
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/crd"
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/templates/model"
 	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/scaffolds"
-	log "github.com/sirupsen/logrus"
+	javautil "github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/util"
 	"github.com/spf13/afero"
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	"sigs.k8s.io/kubebuilder/v3/pkg/config"
 	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v3/pkg/model/resource"
 	"sigs.k8s.io/kubebuilder/v3/pkg/plugin"
-	"sigs.k8s.io/kubebuilder/v3/pkg/plugin/util"
 	pluginutil "sigs.k8s.io/kubebuilder/v3/pkg/plugin/util"
 )
 
-const filePath = "Makefile"
+const (
+	fromCRDFlag        = "from-crd"
+	specFieldFlag      = "spec-field"
+	statusFieldFlag    = "status-field"
+	storageVersionFlag = "storage-version"
+	pluralFlag         = "plural"
+	shortNamesFlag     = "short-names"
+	categoriesFlag     = "categories"
+	printColumnFlag    = "print-column"
+	scaleFlag          = "scale-subresource"
+	noStatusFlag       = "no-status"
+	cleanupFlag        = "with-cleanup"
+	errorStatusFlag    = "with-error-status-handler"
+	eventSourcesFlag   = "with-event-sources"
+)
 
 type createAPIOptions struct {
 	CRDVersion string
 	Namespaced bool
+
+	// FromCRD is the path of an existing CRD to generate the model from
+	FromCRD string
+
+	// SpecFields and StatusFields declare the fields of the spec and status classes as name:type[:validations]
+	SpecFields   []string
+	StatusFields []string
+
+	// StorageVersion marks the version as the one the custom resources are stored as
+	StorageVersion bool
+
+	// Plural overrides the plural derived from the kind
+	Plural string
+
+	// ShortNames and Categories are the short names and categories of the CRD
+	ShortNames []string
+	Categories []string
+
+	// PrintColumns declare the columns kubectl get prints as name:type:jsonPath
+	PrintColumns []string
+
+	// ScaleSubresource enables the scale subresource, declared as specReplicasPath:statusReplicasPath
+	ScaleSubresource string
+
+	// NoStatus disables the status subresource
+	NoStatus bool
+
+	// Reconciler holds the capabilities of the reconciler besides reconciling the custom resources
+	Reconciler scaffolds.ReconcilerOptions
 }
 
 type createAPISubcommand struct {
-	config   config.Config
-	resource *resource.Resource
-	options  createAPIOptions
+	config       config.Config
+	pluginConfig scaffolds.PluginConfig
+	resource     *resource.Resource
+	options      createAPIOptions
+
+	// schema holds the properties of the model read from the CRD, if any
+	schema model.Schema
+
+	// crdFS is the filesystem the CRD is read from, the OS one by default
+	crdFS afero.Fs
+
+	// report collects the changes and the warnings of the subcommand
+	report reporter
 }
 
 func (opts createAPIOptions) UpdateResource(res *resource.Resource) {
@@ -68,11 +121,63 @@ func (p *createAPISubcommand) BindFlags(fs *pflag.FlagSet) {
 	fs.SortFlags = false
 	fs.StringVar(&p.options.CRDVersion, "crd-version", "v1", "crd version to generate")
 	fs.BoolVar(&p.options.Namespaced, "namespaced", true, "resource is namespaced")
+	fs.StringVar(&p.options.FromCRD, fromCRDFlag, "",
+		"path of an existing CRD to generate the typed spec and status classes from, "+
+			"which also sets the group, version, kind, plural and scope of the resource")
+	fs.StringArrayVar(&p.options.SpecFields, specFieldFlag, nil,
+		"field of the spec class as name:type[:validations], e.g. size:int:required,min=1 (repeatable); "+
+			"types are string, int, long, float, double, boolean, int-or-string, object, []<type>, "+
+			"map[string]<type> or a class name, validations are required, min=<n>, max=<n> and pattern=<regex>, "+
+			"the pattern coming last")
+	fs.StringArrayVar(&p.options.StatusFields, statusFieldFlag, nil,
+		"field of the status class as name:type[:validations] (repeatable), see --"+specFieldFlag)
+	fs.BoolVar(&p.options.StorageVersion, storageVersionFlag, false,
+		"store the custom resources as this version, the other versions of the kind being served only; "+
+			"the first version of a kind is always stored")
+	fs.StringVar(&p.options.Plural, pluralFlag, "",
+		"plural of the resource, the default being derived from the kind; the versions of a kind share their plural")
+	fs.StringSliceVar(&p.options.ShortNames, shortNamesFlag, nil,
+		"comma-separated short names of the resource, e.g. for kubectl get; the versions of a kind share them")
+	fs.StringSliceVar(&p.options.Categories, categoriesFlag, nil,
+		"comma-separated categories the resource belongs to, e.g. all for kubectl get all; "+
+			"the versions of a kind share them")
+	fs.StringArrayVar(&p.options.PrintColumns, printColumnFlag, nil,
+		"column kubectl get prints as name:type:jsonPath, e.g. Size:integer:.spec.size (repeatable); "+
+			"types are integer, number, string and boolean, and the path is the one of a field of the spec or "+
+			"status, which is declared if missing")
+	fs.StringVar(&p.options.ScaleSubresource, scaleFlag, "",
+		"enable the scale subresource as specReplicasPath:statusReplicasPath, e.g. .spec.replicas:.status.replicas, "+
+			"the replicas fields being declared if missing")
+	fs.BoolVar(&p.options.NoStatus, noStatusFlag, false,
+		"generate no status class, which disables the status subresource")
+	fs.BoolVar(&p.options.Reconciler.Cleanup, cleanupFlag, false,
+		"implement the cleanup of the custom resources in the reconciler, which adds a finalizer to them; "+
+			"the reconciler is shared by the versions of a kind")
+	fs.BoolVar(&p.options.Reconciler.ErrorStatusHandler, errorStatusFlag, false,
+		"implement the update of the status of the custom resources when their reconciliation fails, "+
+			"which requires quarkus-operator-sdk 4 or later")
+	fs.BoolVar(&p.options.Reconciler.EventSources, eventSourcesFlag, false,
+		"declare the event sources of the secondary resources the reconciler watches, "+
+			"which requires quarkus-operator-sdk 4 or later")
+	p.report.bindFlags(fs)
 }
 
 func (p *createAPISubcommand) InjectConfig(c config.Config) error {
+	if err := p.report.validate(); err != nil {
+		return err
+	}
+	c, err := p.report.isolate(c)
+	if err != nil {
+		return err
+	}
 	p.config = c
 
+	pluginConfig, err := loadPluginConfig(c)
+	if err != nil {
+		return err
+	}
+	p.pluginConfig = pluginConfig
+
 	return nil
 }
 
@@ -85,55 +190,60 @@ func (p *createAPISubcommand) Validate() error {
 }
 
 func (p *createAPISubcommand) PostScaffold() error {
-	return nil
+	return p.report.report(p.nextSteps()...)
 }
 
-func (p *createAPISubcommand) Scaffold(fs machinery.Filesystem) error {
-	scaffolder := scaffolds.NewCreateAPIScaffolder(p.config, *p.resource)
-
-	var s = fmt.Sprintf(makefileBundleCRDFile, p.resource.Plural, p.resource.QualifiedGroup(), p.resource.Version)
-	foundLine := findOldFilesForReplacement(filePath, s)
-
-	if !foundLine {
-		makefileBytes, err := afero.ReadFile(fs.FS, filePath)
-		if err != nil {
-			return err
-		}
-
-		projectName := p.config.GetProjectName()
-		if projectName == "" {
-			dir, err := os.Getwd()
-			if err != nil {
-				return fmt.Errorf("error getting current directory: %w", err)
-			}
-			projectName = strings.ToLower(filepath.Base(dir))
-		}
-
-		makefileBytes = append(makefileBytes, []byte(fmt.Sprintf(makefileBundleVarFragment, p.resource.Plural, p.resource.QualifiedGroup(), p.resource.Version, projectName))...)
-
-		makefileBytes = append([]byte(fmt.Sprintf(makefileBundleImageFragement, p.config.GetDomain(), projectName)), makefileBytes...)
-
-		var mode os.FileMode = 0644
-		if info, err := fs.FS.Stat(filePath); err == nil {
-			mode = info.Mode()
-		}
-		if err := afero.WriteFile(fs.FS, filePath, makefileBytes, mode); err != nil {
-			return fmt.Errorf("error updating Makefile: %w", err)
-		}
+// nextSteps returns the steps following the creation of the API
+func (p *createAPISubcommand) nextSteps() []nextStep {
+	if p.resource == nil {
+		return nil
 	}
+	return []nextStep{{
+		Description: fmt.Sprintf("implement the %s reconciler and build the operator image", p.resource.Kind),
+		Command:     "make docker-build",
+	}}
+}
 
+func (p *createAPISubcommand) Scaffold(fs machinery.Filesystem) error {
+	return p.report.scaffold("create api", fs, p.config, p.scaffold)
+}
+
+// scaffold writes the files of the API to fs
+func (p *createAPISubcommand) scaffold(fs machinery.Filesystem) error {
+	scaffolder := scaffolds.NewCreateAPIScaffolder(p.config, p.pluginConfig, *p.resource, p.schema)
 	scaffolder.InjectFS(fs)
 
 	if err := scaffolder.Scaffold(); err != nil {
 		return err
 	}
-
+	p.report.warnScaffold(scaffolder.Diagnostics())
+	p.report.edit(scaffolder.Edited()...)
 	return nil
 }
 
 func (p *createAPISubcommand) InjectResource(res *resource.Resource) error {
 	p.resource = res
 
+	if p.options.FromCRD != "" {
+		if len(p.options.SpecFields) != 0 || len(p.options.StatusFields) != 0 {
+			return fmt.Errorf("--%s and --%s cannot be used with --%s, which reads the fields from the CRD",
+				specFieldFlag, statusFieldFlag, fromCRDFlag)
+		}
+		if p.options.Plural != "" || len(p.options.ShortNames) != 0 || len(p.options.Categories) != 0 {
+			return fmt.Errorf("--%s, --%s and --%s cannot be used with --%s, which reads the names from the CRD",
+				pluralFlag, shortNamesFlag, categoriesFlag, fromCRDFlag)
+		}
+		if err := p.injectCRD(); err != nil {
+			return err
+		}
+	} else if err := p.injectFields(); err != nil {
+		return err
+	}
+
+	if p.options.Plural != "" {
+		p.resource.Plural = p.options.Plural
+	}
+
 	// RESOURCE: &{{cache zeusville.com v1 Joke} jokes  0xc00082a640 false 0xc00082a680}
 	p.options.UpdateResource(p.resource)
 
@@ -141,6 +251,10 @@ func (p *createAPISubcommand) InjectResource(res *resource.Resource) error {
 		return err
 	}
 
+	if err := p.injectPlural(); err != nil {
+		return err
+	}
+
 	// Check that resource doesn't have the API scaffolded
 	if res, err := p.config.GetResource(p.resource.GVK); err == nil && res.HasAPI() {
 		return errors.New("the API resource already exists")
@@ -151,89 +265,316 @@ func (p *createAPISubcommand) InjectResource(res *resource.Resource) error {
 		return fmt.Errorf("multiple groups are not allowed by default, to enable multi-group set 'multigroup: true' in your PROJECT file")
 	}
 
+	if err := p.validateClassNames(); err != nil {
+		return err
+	}
+	p.warnJavaNames()
+
 	// Selected CRD version must match existing CRD versions.
 	if pluginutil.HasDifferentCRDVersion(p.config, p.resource.API.CRDVersion) {
 		return fmt.Errorf("only one CRD version can be used for all resources, cannot add %q", p.resource.API.CRDVersion)
 	}
 
+	if err := p.injectCRDNames(); err != nil {
+		return err
+	}
+
+	if err := p.injectAPIOptions(); err != nil {
+		return err
+	}
+
+	if err := p.injectReconcilerOptions(); err != nil {
+		return err
+	}
+
+	return p.injectStorageVersion()
+}
+
+// injectPlural checks that the plural given with --plural or read from the CRD is the one of the other versions of
+// the kind, which share their CRD, and defaults to it
+func (p *createAPISubcommand) injectPlural() error {
+	versions, err := p.otherVersions()
+	if err != nil || len(versions) == 0 || versions[0].Plural == p.resource.Plural {
+		return err
+	}
+	if p.options.Plural != "" || p.options.FromCRD != "" {
+		return fmt.Errorf("plural %q does not match the plural %q of version %s of kind %s, "+
+			"which the versions of a kind share", p.resource.Plural, versions[0].Plural, versions[0].Version,
+			p.resource.Kind)
+	}
+	p.resource.Plural = versions[0].Plural
 	return nil
 }
 
-// findOldFilesForReplacement verifies marker (## marker) and if it found then merge new api CRD file to the odler logic
-func findOldFilesForReplacement(path, newfile string) bool {
+// injectCRDNames records the short names and categories given with --short-names and --categories, or read from
+// the CRD, which must match the ones of the other versions of the kind
+func (p *createAPISubcommand) injectCRDNames() error {
+	names := scaffolds.CRDNames{ShortNames: p.options.ShortNames, Categories: p.options.Categories}
+	for flag, values := range map[string][]string{shortNamesFlag: names.ShortNames, categoriesFlag: names.Categories} {
+		if err := validateNames(values); err != nil {
+			return fmt.Errorf("invalid --%s: %w", flag, err)
+		}
+	}
+
+	versions, err := p.otherVersions()
+	if err != nil {
+		return err
+	}
+
+	existing := p.pluginConfig.CRDNames(*p.resource)
+	switch {
+	case names.IsEmpty():
+		// The names of the other versions are used, if any
+		return nil
+	case len(versions) == 0:
+		p.pluginConfig.SetCRDNames(*p.resource, names)
+		return savePluginConfig(p.config, p.pluginConfig)
+	case reflect.DeepEqual(names, existing):
+		return nil
+	}
+	return fmt.Errorf("the short names and categories of kind %s are set along with its first version, "+
+		"and are shared by its versions", p.resource.Kind)
+}
+
+// injectAPIOptions records the printer columns and the subresources given with --print-column, --scale-subresource
+// and --no-status, once checked against the fields of the model
+func (p *createAPISubcommand) injectAPIOptions() error {
+	options := scaffolds.APIOptions{NoStatus: p.options.NoStatus}
+	for _, declaration := range p.options.PrintColumns {
+		column, err := scaffolds.ParsePrintColumn(declaration)
+		if err != nil {
+			return fmt.Errorf("invalid --%s: %w", printColumnFlag, err)
+		}
+		options.PrintColumns = append(options.PrintColumns, column)
+	}
+	if p.options.ScaleSubresource != "" {
+		var err error
+		if options.Scale, err = scaffolds.ParseScale(p.options.ScaleSubresource); err != nil {
+			return fmt.Errorf("invalid --%s: %w", scaleFlag, err)
+		}
+	}
+
+	if options.IsEmpty() {
+		return nil
+	}
+	if _, err := options.Apply(p.schema); err != nil {
+		return err
+	}
+	p.pluginConfig.SetAPIOptions(*p.resource, options)
+	return savePluginConfig(p.config, p.pluginConfig)
+}
 
-	f, err := os.Open(path)
+// injectReconcilerOptions records the capabilities of the reconciler given with --with-cleanup,
+// --with-error-status-handler and --with-event-sources. The reconciler is scaffolded along with the first version
+// of the kind only.
+func (p *createAPISubcommand) injectReconcilerOptions() error {
+	options := p.options.Reconciler
+	if options.IsEmpty() {
+		return nil
+	}
+
+	versions, err := p.otherVersions()
 	if err != nil {
-		log.Fatal(err)
+		return err
+	}
+	if len(versions) != 0 {
+		return fmt.Errorf("the reconciler of kind %s is scaffolded along with its first version, "+
+			"--%s, --%s and --%s cannot be used for the later ones", p.resource.Kind, cleanupFlag, errorStatusFlag,
+			eventSourcesFlag)
+	}
+
+	if (options.ErrorStatusHandler || options.EventSources) && !p.pluginConfig.GenericContext() {
+		operatorSDKVersion := p.pluginConfig.OperatorSDKVersion
+		if operatorSDKVersion == "" {
+			operatorSDKVersion = scaffolds.DefaultOperatorSDKVersion
+		}
+		return fmt.Errorf("--%s and --%s require quarkus-operator-sdk 4 or later, the project uses %s; "+
+			"upgrade it with edit --%s", errorStatusFlag, eventSourcesFlag, operatorSDKVersion, upgradeFlag)
 	}
 
-	// remember to close the file at the end of the program
-	defer f.Close()
+	p.pluginConfig.SetReconcilerOptions(*p.resource, options)
+	return savePluginConfig(p.config, p.pluginConfig)
+}
 
-	// read the file line by line using scanner
-	scanner := bufio.NewScanner(f)
-	var foundMarker bool
-	for scanner.Scan() {
-		// do something with a line
-		if scanner.Text() == "## marker" {
-			foundMarker = true
-			break
+// otherVersions returns the other versions of the resource kind the project holds
+func (p *createAPISubcommand) otherVersions() ([]resource.Resource, error) {
+	resources, err := p.config.GetResources()
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []resource.Resource
+	for _, res := range resources {
+		if res.QualifiedGroup() == p.resource.QualifiedGroup() && res.Kind == p.resource.Kind &&
+			res.Version != p.resource.Version {
+			versions = append(versions, res)
 		}
 	}
+	return versions, nil
+}
 
-	if foundMarker {
-		scanner.Scan()
-		catLine := scanner.Text()
+// validateNames checks that the short names or categories are lower case DNS labels, listed once
+func validateNames(names []string) error {
+	seen := map[string]bool{}
+	for _, name := range names {
+		if errs := validation.IsDNS1035Label(name); len(errs) != 0 {
+			return fmt.Errorf("%q is invalid: %s", name, strings.Join(errs, ", "))
+		}
+		if seen[name] {
+			return fmt.Errorf("%q is listed twice", name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
 
-		splitByPipe := strings.Split(catLine, "|")
+// validateClassNames returns an error if the classes of the resource would collide with the classes of
+// another kind, e.g. for kinds of different groups sharing their package in single-group projects
+func (p *createAPISubcommand) validateClassNames() error {
+	resources, err := p.config.GetResources()
+	if err != nil {
+		return err
+	}
 
-		finalString := strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(splitByPipe[0]), "cat"), "target/kubernetes/kubernetes.yml")
+	multiGroup := p.config.IsMultiGroup()
+	resourcePackage := p.pluginConfig.ResourcePackage(*p.resource, multiGroup)
+	modelPackage := p.pluginConfig.ModelPackage(*p.resource, multiGroup)
+	classNames := map[string]bool{}
+	for _, name := range scaffolds.ModelClassNames(p.resource.Kind) {
+		classNames[name] = true
+	}
 
-		updatedLine := "	" + "cat" + finalString + newfile + " target/kubernetes/kubernetes.yml" + " |" + splitByPipe[1]
+	for _, res := range resources {
+		if res.QualifiedGroup() == p.resource.QualifiedGroup() && res.Kind == p.resource.Kind {
+			// The versions of a kind share their reconciler and have their own model package
+			continue
+		}
 
-		if err := scanner.Err(); err != nil {
-			log.Error(err, "Unable to scan existing bundle target command from the Makefile. New bundle target command being created. This may overwrite any existing commands.")
-			return false
+		collision := ""
+		if p.pluginConfig.ResourcePackage(res, multiGroup) == resourcePackage &&
+			scaffolds.ModelClassName(res.Kind) == scaffolds.ModelClassName(p.resource.Kind) {
+			collision = resourcePackage + "." + scaffolds.ModelClassName(res.Kind) + "Reconciler"
+		} else if p.pluginConfig.ModelPackage(res, multiGroup) == modelPackage {
+			for _, name := range scaffolds.ModelClassNames(res.Kind) {
+				if classNames[name] {
+					collision = modelPackage + "." + name
+					break
+				}
+			}
 		}
 
-		// ReplaceInFile replaces all instances of old with new in the file at path.
-		err = util.ReplaceInFile(path, catLine, updatedLine)
-		if err != nil {
-			log.Error(err, "Unable to replace existing bundle target command from the Makefile. New bundle target command being created. This may overwrite any existing commands.")
-			return false
+		if collision != "" {
+			return fmt.Errorf("kind %s of group %q would generate the Java class %s, which is already generated "+
+				"for kind %s of group %q", p.resource.Kind, p.resource.QualifiedGroup(), collision, res.Kind,
+				res.QualifiedGroup())
 		}
 	}
 
-	return foundMarker
+	return nil
 }
 
-const (
-	makefileBundleCRDFile = `target/kubernetes/%[1]s.%[2]s-%[3]s.yml`
-)
+// warnJavaNames reports how the kind and, in multi-group projects, the group are changed into legal Java names
+func (p *createAPISubcommand) warnJavaNames() {
+	if _, warning := javautil.KindClassName(p.resource.Kind); warning != nil {
+		p.report.warnNames(*warning)
+	}
+	if p.config.IsMultiGroup() {
+		_, warnings := javautil.SanitizePackage(p.resource.Group)
+		p.report.warnNames(warnings...)
+	}
+}
 
-const (
-	makefileBundleVarFragment = `
-##@Bundle
-.PHONY: bundle
-bundle:  ## Generate bundle manifests and metadata, then validate generated files.
-## marker
-	cat target/kubernetes/%[1]s.%[2]s-%[3]s.yml target/kubernetes/kubernetes.yml | operator-sdk generate bundle -q --overwrite --version 0.1.1 --default-channel=stable --channels=stable --package=%[4]s
-	operator-sdk bundle validate ./bundle
-	
-.PHONY: bundle-build
-bundle-build: ## Build the bundle image.
-	docker build -f bundle.Dockerfile -t $(BUNDLE_IMG) .
-	
-.PHONY: bundle-push
-bundle-push: ## Push the bundle image.
-	docker push $(BUNDLE_IMG)
-`
-)
+// injectStorageVersion records the storage version of the resource kind, which is the first version of the kind
+// unless --storage-version is set
+func (p *createAPISubcommand) injectStorageVersion() error {
+	versions, err := p.otherVersions()
+	if err != nil {
+		return err
+	}
 
-const (
-	makefileBundleImageFragement = `
-VERSION ?= 0.0.1
-IMAGE_TAG_BASE ?= %[1]s/%[2]s
-BUNDLE_IMG ?= $(IMAGE_TAG_BASE)-bundle:v$(VERSION)
-`
-)
+	switch {
+	case p.options.StorageVersion || len(versions) == 0:
+		p.pluginConfig.SetStorageVersion(*p.resource)
+	case p.pluginConfig.StorageVersion(*p.resource) == "":
+		// The storage version of projects scaffolded before it was recorded is the first version of the kind
+		previous := *p.resource
+		previous.Version = versions[0].Version
+		p.pluginConfig.SetStorageVersion(previous)
+	default:
+		return nil
+	}
+
+	return savePluginConfig(p.config, p.pluginConfig)
+}
+
+// injectCRD sets the resource and the model schema from the CRD given with --from-crd. The group, version and kind
+// given with the flags, if any, must match the ones of the CRD.
+func (p *createAPISubcommand) injectCRD() error {
+	if p.crdFS == nil {
+		p.crdFS = afero.NewOsFs()
+	}
+
+	data, err := afero.ReadFile(p.crdFS, p.options.FromCRD)
+	if err != nil {
+		return fmt.Errorf("error reading --%s: %w", fromCRDFlag, err)
+	}
+	definition, err := crd.Parse(data)
+	if err != nil {
+		return fmt.Errorf("error reading --%s %s: %w", fromCRDFlag, p.options.FromCRD, err)
+	}
+
+	if p.resource.Kind != "" && p.resource.Kind != definition.Spec.Names.Kind {
+		return fmt.Errorf("kind %q does not match the kind %q of the CRD", p.resource.Kind, definition.Spec.Names.Kind)
+	}
+	if p.resource.Group != "" && p.resource.QualifiedGroup() != definition.Spec.Group {
+		return fmt.Errorf("group %q does not match the group %q of the CRD", p.resource.QualifiedGroup(), definition.Spec.Group)
+	}
+
+	// Use the storage version unless a version is given
+	version, err := definition.Version(p.resource.Version)
+	if err != nil {
+		return err
+	}
+	if p.schema, err = version.ModelSchema(definition.Spec.Names.Kind); err != nil {
+		return err
+	}
+
+	p.resource.Group, p.resource.Domain = splitGroup(definition.Spec.Group, p.config.GetDomain())
+	p.resource.Version = version.Name
+	p.resource.Kind = definition.Spec.Names.Kind
+	p.resource.Plural = definition.Spec.Names.Plural
+	p.options.ShortNames = definition.Spec.Names.ShortNames
+	p.options.Categories = definition.Spec.Names.Categories
+	p.options.Namespaced = definition.Namespaced()
+
+	return nil
+}
+
+// injectFields sets the model schema from the fields given with --spec-field and --status-field
+func (p *createAPISubcommand) injectFields() error {
+	var err error
+	if p.schema.Spec.Fields, err = model.ParseFields(p.options.SpecFields); err != nil {
+		return fmt.Errorf("invalid --%s: %w", specFieldFlag, err)
+	}
+	if p.schema.Status.Fields, err = model.ParseFields(p.options.StatusFields); err != nil {
+		return fmt.Errorf("invalid --%s: %w", statusFieldFlag, err)
+	}
+	return nil
+}
+
+// splitGroup splits the fully qualified group of a CRD into the group and the domain of a resource, the domain
+// being the project one if the group belongs to it
+func splitGroup(qualifiedGroup, domain string) (string, string) {
+	switch {
+	case qualifiedGroup == domain:
+		return "", domain
+	case strings.HasSuffix(qualifiedGroup, "."+domain):
+		return strings.TrimSuffix(qualifiedGroup, "."+domain), domain
+	}
+
+	parts := strings.SplitN(qualifiedGroup, ".", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
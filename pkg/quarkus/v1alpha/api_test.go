@@ -17,6 +17,8 @@ package v1
 import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/scaffolds"
+	"github.com/spf13/afero"
 	"github.com/spf13/pflag"
 	"sigs.k8s.io/kubebuilder/v3/pkg/config"
 	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
@@ -54,6 +56,20 @@ var _ = Describe("v1", func() {
 			Expect(flagTest.SortFlags).To(BeFalse())
 			Expect(testAPISubcommand.options.CRDVersion).To(Equal("v1"))
 			Expect(testAPISubcommand.options.Namespaced).To(BeTrue())
+			Expect(testAPISubcommand.options.FromCRD).To(BeEmpty())
+			Expect(testAPISubcommand.options.SpecFields).To(BeEmpty())
+			Expect(testAPISubcommand.options.StatusFields).To(BeEmpty())
+		})
+
+		It("should accept repeated fields holding commas", func() {
+			flagTest := pflag.NewFlagSet("testFlag", -1)
+			testAPISubcommand.BindFlags(flagTest)
+			Expect(flagTest.Parse([]string{
+				"--spec-field", "size:int:required,min=1", "--spec-field", "image:string",
+				"--status-field", "nodes:[]string",
+			})).To(Succeed())
+			Expect(testAPISubcommand.options.SpecFields).To(Equal([]string{"size:int:required,min=1", "image:string"}))
+			Expect(testAPISubcommand.options.StatusFields).To(Equal([]string{"nodes:[]string"}))
 		})
 	})
 
@@ -125,5 +141,366 @@ var _ = Describe("v1", func() {
 			Expect(testAPISubcommand.resource, testResource)
 			Expect(noErr).To(BeNil())
 		})
+
+		Context("with several versions of a kind", func() {
+			var testConfig config.Config
+
+			newResource := func(version string) resource.Resource {
+				return resource.Resource{
+					GVK:    resource.GVK{Group: "cache", Domain: "example.com", Version: version, Kind: "Memcached"},
+					Plural: "memcacheds",
+				}
+			}
+
+			BeforeEach(func() {
+				testConfig, _ = config.New(config.Version{Number: 3})
+				Expect(testConfig.SetDomain("example.com")).To(Succeed())
+			})
+
+			inject := func(version string, storage bool) scaffolds.PluginConfig {
+				subcommand := &createAPISubcommand{options: createAPIOptions{CRDVersion: "v1", StorageVersion: storage}}
+				Expect(subcommand.InjectConfig(testConfig)).To(Succeed())
+				res := newResource(version)
+				Expect(subcommand.InjectResource(&res)).To(Succeed())
+				Expect(testConfig.AddResource(res)).To(Succeed())
+				return subcommand.pluginConfig
+			}
+
+			It("stores the first version until --storage-version is set", func() {
+				Expect(inject("v1", false).StorageVersion(newResource("v1"))).To(Equal("v1"))
+				Expect(inject("v2", false).StorageVersion(newResource("v2"))).To(Equal("v1"))
+				Expect(inject("v3", true).StorageVersion(newResource("v3"))).To(Equal("v3"))
+
+				pluginConfig, err := loadPluginConfig(testConfig)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pluginConfig.StorageVersions).To(Equal(map[string]string{"memcacheds.cache.example.com": "v3"}))
+			})
+
+			It("stores the existing version of projects which did not record it", func() {
+				Expect(testConfig.AddResource(newResource("v1"))).To(Succeed())
+				Expect(inject("v2", false).StorageVersion(newResource("v2"))).To(Equal("v1"))
+			})
+		})
+
+		Context("in a multi-group project", func() {
+			var testConfig config.Config
+
+			BeforeEach(func() {
+				testConfig, _ = config.New(config.Version{Number: 3})
+				Expect(testConfig.SetDomain("example.com")).To(Succeed())
+				Expect(testConfig.SetMultiGroup()).To(Succeed())
+				Expect(testConfig.AddResource(resource.Resource{
+					GVK: resource.GVK{Group: "cache", Domain: "example.com", Version: "v1", Kind: "Memcached"},
+				})).To(Succeed())
+			})
+
+			inject := func(gvk resource.GVK) error {
+				subcommand := &createAPISubcommand{options: createAPIOptions{CRDVersion: "v1"}}
+				Expect(subcommand.InjectConfig(testConfig)).To(Succeed())
+				res := resource.Resource{GVK: gvk, Plural: resource.RegularPlural(gvk.Kind)}
+				return subcommand.InjectResource(&res)
+			}
+
+			It("accepts the same kind in another group", func() {
+				Expect(inject(resource.GVK{Group: "apps", Domain: "example.com", Version: "v1", Kind: "Memcached"})).
+					To(Succeed())
+			})
+
+			It("rejects the kinds whose classes collide", func() {
+				Expect(inject(resource.GVK{Group: "cache", Domain: "example.com", Version: "v1", Kind: "MemcachedSpec"})).
+					To(MatchError(ContainSubstring("would generate the Java class com.example.cache.v1.MemcachedSpec")))
+				Expect(inject(resource.GVK{Group: "cache", Domain: "other.io", Version: "v2", Kind: "Memcached"})).
+					To(MatchError(ContainSubstring("would generate the Java class com.example.cache.MemcachedReconciler")))
+			})
+		})
+
+		Context("with --spec-field and --status-field", func() {
+			var testResource resource.Resource
+
+			BeforeEach(func() {
+				testConfig, _ := config.New(config.Version{Number: 3})
+				Expect(testAPISubcommand.InjectConfig(testConfig)).To(Succeed())
+				testResource = resource.Resource{
+					GVK:    resource.GVK{Group: "cache", Version: "v1", Kind: "Memcached"},
+					Plural: "memcacheds",
+				}
+			})
+
+			It("sets the model schema from the fields", func() {
+				testAPISubcommand.options.SpecFields = []string{"size:int:required", "labels:map[string]string"}
+				testAPISubcommand.options.StatusFields = []string{"nodes:[]string"}
+				Expect(testAPISubcommand.InjectResource(&testResource)).To(Succeed())
+
+				Expect(testAPISubcommand.schema.Spec.Fields).To(HaveLen(2))
+				Expect(testAPISubcommand.schema.Spec.Fields[0].Required).To(BeTrue())
+				Expect(testAPISubcommand.schema.Status.Fields).To(HaveLen(1))
+				Expect(testAPISubcommand.schema.Status.Fields[0].Type.Java()).To(Equal("List<String>"))
+			})
+
+			It("fails on invalid fields", func() {
+				testAPISubcommand.options.SpecFields = []string{"size:unknown"}
+				Expect(testAPISubcommand.InjectResource(&testResource)).To(MatchError(ContainSubstring("--spec-field")))
+			})
+
+			It("fails along with --from-crd", func() {
+				testAPISubcommand.options.FromCRD = "crd.yaml"
+				testAPISubcommand.options.StatusFields = []string{"nodes:[]string"}
+				Expect(testAPISubcommand.InjectResource(&testResource)).To(MatchError(ContainSubstring("cannot be used")))
+			})
+		})
+
+		Context("with --plural, --short-names and --categories", func() {
+			var testConfig config.Config
+
+			BeforeEach(func() {
+				testConfig, _ = config.New(config.Version{Number: 3})
+				Expect(testConfig.SetDomain("example.com")).To(Succeed())
+			})
+
+			inject := func(version string, options createAPIOptions) (*createAPISubcommand, *resource.Resource, error) {
+				options.CRDVersion = "v1"
+				subcommand := &createAPISubcommand{options: options}
+				Expect(subcommand.InjectConfig(testConfig)).To(Succeed())
+				res := &resource.Resource{
+					GVK:    resource.GVK{Group: "cache", Domain: "example.com", Version: version, Kind: "Memcached"},
+					Plural: "memcacheds",
+				}
+				return subcommand, res, subcommand.InjectResource(res)
+			}
+
+			It("sets the plural and records the names of the CRD", func() {
+				subcommand, res, err := inject("v1", createAPIOptions{
+					Plural: "memcachedes", ShortNames: []string{"mc", "mcd"}, Categories: []string{"all"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res.Plural).To(Equal("memcachedes"))
+				Expect(subcommand.pluginConfig.CRDNames(*res)).To(Equal(scaffolds.CRDNames{
+					ShortNames: []string{"mc", "mcd"}, Categories: []string{"all"},
+				}))
+
+				// The names are saved for the other versions of the kind
+				pluginConfig, err := loadPluginConfig(testConfig)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pluginConfig.CRDNames(*res).ShortNames).To(Equal([]string{"mc", "mcd"}))
+			})
+
+			It("shares the plural and the names of the CRD between the versions of a kind", func() {
+				_, v1, err := inject("v1", createAPIOptions{Plural: "memcachedes", ShortNames: []string{"mc"}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(testConfig.AddResource(*v1)).To(Succeed())
+
+				subcommand, v2, err := inject("v2", createAPIOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(v2.Plural).To(Equal("memcachedes"))
+				Expect(subcommand.pluginConfig.CRDNames(*v2).ShortNames).To(Equal([]string{"mc"}))
+
+				_, _, err = inject("v2", createAPIOptions{ShortNames: []string{"mc"}})
+				Expect(err).NotTo(HaveOccurred())
+				_, _, err = inject("v2", createAPIOptions{Plural: "memcacheds"})
+				Expect(err).To(MatchError(ContainSubstring(`plural "memcacheds" does not match the plural "memcachedes"`)))
+				_, _, err = inject("v2", createAPIOptions{Categories: []string{"all"}})
+				Expect(err).To(MatchError(ContainSubstring("are set along with its first version")))
+			})
+
+			It("fails on invalid names", func() {
+				_, _, err := inject("v1", createAPIOptions{Plural: "Memcacheds"})
+				Expect(err).To(MatchError(ContainSubstring("invalid Plural")))
+				_, _, err = inject("v1", createAPIOptions{ShortNames: []string{"mc", "MC"}})
+				Expect(err).To(MatchError(ContainSubstring(`invalid --short-names: "MC" is invalid`)))
+				_, _, err = inject("v1", createAPIOptions{Categories: []string{"all", "all"}})
+				Expect(err).To(MatchError(`invalid --categories: "all" is listed twice`))
+			})
+
+			It("fails along with --from-crd", func() {
+				_, _, err := inject("v1", createAPIOptions{FromCRD: "crd.yaml", ShortNames: []string{"mc"}})
+				Expect(err).To(MatchError(ContainSubstring("which reads the names from the CRD")))
+			})
+		})
+
+		Context("with --print-column, --scale-subresource and --no-status", func() {
+			var testConfig config.Config
+
+			BeforeEach(func() {
+				testConfig, _ = config.New(config.Version{Number: 3})
+				Expect(testConfig.SetDomain("example.com")).To(Succeed())
+			})
+
+			inject := func(options createAPIOptions) (*createAPISubcommand, error) {
+				options.CRDVersion = "v1"
+				subcommand := &createAPISubcommand{options: options}
+				Expect(subcommand.InjectConfig(testConfig)).To(Succeed())
+				res := &resource.Resource{
+					GVK:    resource.GVK{Group: "cache", Domain: "example.com", Version: "v1", Kind: "Memcached"},
+					Plural: "memcacheds",
+				}
+				return subcommand, subcommand.InjectResource(res)
+			}
+
+			It("records the printer columns and the subresources of the version", func() {
+				subcommand, err := inject(createAPIOptions{
+					SpecFields:       []string{"size:int:required"},
+					PrintColumns:     []string{"Size:integer:.spec.size"},
+					ScaleSubresource: ".spec.size:.status.replicas",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(subcommand.pluginConfig.APIOptions(*subcommand.resource)).To(Equal(scaffolds.APIOptions{
+					PrintColumns: []scaffolds.PrintColumn{{Name: "Size", Type: "integer", JSONPath: ".spec.size"}},
+					Scale:        &scaffolds.Scale{SpecReplicasPath: ".spec.size", StatusReplicasPath: ".status.replicas"},
+				}))
+
+				pluginConfig, err := loadPluginConfig(testConfig)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pluginConfig.APIs).To(HaveKey("memcacheds.cache.example.com/v1"))
+			})
+
+			It("records nothing by default", func() {
+				subcommand, err := inject(createAPIOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(subcommand.pluginConfig.APIs).To(BeEmpty())
+			})
+
+			It("fails on invalid options", func() {
+				_, err := inject(createAPIOptions{PrintColumns: []string{"Size:int:.spec.size"}})
+				Expect(err).To(MatchError(ContainSubstring(`invalid --print-column: invalid column "Size:int:.spec.size"`)))
+				_, err = inject(createAPIOptions{ScaleSubresource: ".spec.replicas"})
+				Expect(err).To(MatchError(ContainSubstring("invalid --scale-subresource")))
+				_, err = inject(createAPIOptions{SpecFields: []string{"size:string"}, PrintColumns: []string{"Size:integer:.spec.size"}})
+				Expect(err).To(MatchError(ContainSubstring(".spec.size is not a field of type int or long")))
+				_, err = inject(createAPIOptions{NoStatus: true, StatusFields: []string{"nodes:[]string"}})
+				Expect(err).To(MatchError(ContainSubstring("status fields cannot be declared")))
+			})
+		})
+
+		Context("with --with-cleanup, --with-error-status-handler and --with-event-sources", func() {
+			var testConfig config.Config
+
+			BeforeEach(func() {
+				testConfig, _ = config.New(config.Version{Number: 3})
+				Expect(testConfig.SetDomain("example.com")).To(Succeed())
+				Expect(savePluginConfig(testConfig, scaffolds.PluginConfig{
+					OperatorSDKVersion: scaffolds.LatestOperatorSDKVersion,
+				})).To(Succeed())
+			})
+
+			inject := func(version string, options scaffolds.ReconcilerOptions) (*createAPISubcommand, *resource.Resource, error) {
+				subcommand := &createAPISubcommand{options: createAPIOptions{CRDVersion: "v1", Reconciler: options}}
+				Expect(subcommand.InjectConfig(testConfig)).To(Succeed())
+				res := &resource.Resource{
+					GVK:    resource.GVK{Group: "cache", Domain: "example.com", Version: version, Kind: "Memcached"},
+					Plural: "memcacheds",
+				}
+				return subcommand, res, subcommand.InjectResource(res)
+			}
+
+			It("records the capabilities of the reconciler", func() {
+				options := scaffolds.ReconcilerOptions{Cleanup: true, ErrorStatusHandler: true, EventSources: true}
+				_, res, err := inject("v1", options)
+				Expect(err).NotTo(HaveOccurred())
+
+				pluginConfig, err := loadPluginConfig(testConfig)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pluginConfig.ReconcilerOptions(*res)).To(Equal(options))
+				Expect(pluginConfig.Reconcilers).To(HaveKey("memcacheds.cache.example.com"))
+			})
+
+			It("fails for the later versions of a kind, which share the reconciler", func() {
+				_, v1, err := inject("v1", scaffolds.ReconcilerOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(testConfig.AddResource(*v1)).To(Succeed())
+
+				_, _, err = inject("v2", scaffolds.ReconcilerOptions{Cleanup: true})
+				Expect(err).To(MatchError(ContainSubstring("scaffolded along with its first version")))
+			})
+
+			It("fails on quarkus-operator-sdk versions without error status handlers and event sources", func() {
+				Expect(savePluginConfig(testConfig, scaffolds.PluginConfig{})).To(Succeed())
+
+				_, _, err := inject("v1", scaffolds.ReconcilerOptions{EventSources: true})
+				Expect(err).To(MatchError(ContainSubstring(
+					"require quarkus-operator-sdk 4 or later, the project uses " + scaffolds.DefaultOperatorSDKVersion)))
+
+				_, _, err = inject("v1", scaffolds.ReconcilerOptions{Cleanup: true})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("with --from-crd", func() {
+			const crdYAML = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+spec:
+  group: cache.example.com
+  names:
+    kind: Memcached
+    plural: memcachedes
+    shortNames:
+    - mc
+  scope: Cluster
+  versions:
+  - name: v1
+    served: true
+    storage: true
+    schema:
+      openAPIV3Schema:
+        type: object
+        properties:
+          spec:
+            type: object
+            properties:
+              size:
+                type: integer
+`
+
+			var (
+				fromCRDSubcommand *createAPISubcommand
+				testResource      resource.Resource
+			)
+
+			BeforeEach(func() {
+				testConfig, _ := config.New(config.Version{Number: 3})
+				Expect(testConfig.SetDomain("example.com")).To(Succeed())
+
+				fromCRDSubcommand = &createAPISubcommand{crdFS: afero.NewMemMapFs()}
+				fromCRDSubcommand.options = createAPIOptions{CRDVersion: "v1", Namespaced: true, FromCRD: "crd.yaml"}
+				Expect(afero.WriteFile(fromCRDSubcommand.crdFS, "crd.yaml", []byte(crdYAML), 0644)).To(Succeed())
+				Expect(fromCRDSubcommand.InjectConfig(testConfig)).To(Succeed())
+
+				testResource = resource.Resource{GVK: resource.GVK{Domain: "example.com"}}
+			})
+
+			It("sets the resource from the CRD", func() {
+				Expect(fromCRDSubcommand.InjectResource(&testResource)).To(Succeed())
+				Expect(testResource.GVK).To(Equal(resource.GVK{
+					Group: "cache", Domain: "example.com", Version: "v1", Kind: "Memcached",
+				}))
+				Expect(testResource.Plural).To(Equal("memcachedes"))
+				Expect(fromCRDSubcommand.pluginConfig.CRDNames(testResource).ShortNames).To(Equal([]string{"mc"}))
+				Expect(testResource.API.Namespaced).To(BeFalse())
+				Expect(fromCRDSubcommand.schema.Spec.Fields).To(HaveLen(1))
+				Expect(fromCRDSubcommand.schema.Spec.Fields[0].Name).To(Equal("size"))
+			})
+
+			It("fails if the kind or the version do not match the CRD", func() {
+				testResource.Kind = "Other"
+				Expect(fromCRDSubcommand.InjectResource(&testResource)).To(MatchError(ContainSubstring("does not match")))
+
+				testResource.Kind = "Memcached"
+				testResource.Version = "v2"
+				Expect(fromCRDSubcommand.InjectResource(&testResource)).To(MatchError(ContainSubstring(`no version "v2"`)))
+			})
+
+			It("fails if the CRD cannot be read", func() {
+				fromCRDSubcommand.options.FromCRD = "missing.yaml"
+				Expect(fromCRDSubcommand.InjectResource(&testResource)).To(MatchError(ContainSubstring("--from-crd")))
+			})
+		})
+
+		It("splits the CRD group into the resource group and domain", func() {
+			group, domain := splitGroup("cache.example.com", "example.com")
+			Expect([]string{group, domain}).To(Equal([]string{"cache", "example.com"}))
+			group, domain = splitGroup("example.com", "example.com")
+			Expect([]string{group, domain}).To(Equal([]string{"", "example.com"}))
+			group, domain = splitGroup("apps.other.io", "example.com")
+			Expect([]string{group, domain}).To(Equal([]string{"apps", "other.io"}))
+		})
 	})
 })
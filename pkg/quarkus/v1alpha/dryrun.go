@@ -0,0 +1,191 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	iofs "io/fs"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	"sigs.k8s.io/kubebuilder/v3/pkg/config/store/yaml"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+const dryRunFlag = "dry-run"
+
+// editedFiles are the files of the project the plugin updates in place, whose changes are reported as diffs in
+// dry-run mode. The sources are only updated in place by some scaffolders, which report them as edited.
+var editedFiles = map[string]bool{
+	yaml.DefaultPath:    true,
+	"Makefile":          true,
+	"pom.xml":           true,
+	"build.gradle.kts":  true,
+	"gradle.properties": true,
+	"src/main/resources/application.properties": true,
+}
+
+// projectSnapshot is the PROJECT file as it was before a dry run. The CLI saves the PROJECT file after the
+// scaffolding, which plugins cannot prevent without failing the command, so the file is restored afterwards.
+type projectSnapshot struct {
+	fs       machinery.Filesystem
+	exists   bool
+	contents []byte
+	mode     iofs.FileMode
+}
+
+// snapshotProject records the PROJECT file of fs, if any
+func snapshotProject(fs machinery.Filesystem) (*projectSnapshot, error) {
+	snapshot := &projectSnapshot{fs: fs}
+	info, err := fs.FS.Stat(yaml.DefaultPath)
+	if errors.Is(err, iofs.ErrNotExist) {
+		return snapshot, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	contents, err := afero.ReadFile(fs.FS, yaml.DefaultPath)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.exists, snapshot.contents, snapshot.mode = true, contents, info.Mode()
+	return snapshot, nil
+}
+
+// restore writes the PROJECT file back as it was before the dry run, removing it if there was none
+func (s *projectSnapshot) restore() error {
+	if !s.exists {
+		if err := s.fs.FS.Remove(yaml.DefaultPath); err != nil && !errors.Is(err, iofs.ErrNotExist) {
+			return fmt.Errorf("error removing %s: %w", yaml.DefaultPath, err)
+		}
+		return nil
+	}
+
+	if err := afero.WriteFile(s.fs.FS, yaml.DefaultPath, s.contents, s.mode); err != nil {
+		return fmt.Errorf("error restoring %s: %w", yaml.DefaultPath, err)
+	}
+	return nil
+}
+
+// fileChange is a change the scaffolding makes to a file of the project
+type fileChange struct {
+	Path   string
+	Action string
+	// Before and After are the contents of the file before and after the scaffolding
+	Before, After []byte
+}
+
+// scaffoldOverlay runs scaffold against an in-memory overlay of fs, leaving fs untouched, and returns the layer
+// holding the files it wrote along with the changes it made, including the ones of the PROJECT file. The existing
+// files are modified if scaffold edited them in place, overwritten otherwise.
+func scaffoldOverlay(fs machinery.Filesystem, cfg config.Config, scaffold func(machinery.Filesystem) error,
+	edited map[string]bool) (afero.Fs, []fileChange, error) {
+	layer := afero.NewMemMapFs()
+	overlay := machinery.Filesystem{FS: afero.NewCopyOnWriteFs(afero.NewReadOnlyFs(fs.FS), layer)}
+	if err := scaffold(overlay); err != nil {
+		return nil, nil, err
+	}
+
+	contents := map[string][]byte{}
+	err := afero.Walk(layer, ".", func(path string, info iofs.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		contents[path], err = afero.ReadFile(layer, path)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The configuration is saved by the CLI once the subcommand succeeded, so its changes are computed here
+	if contents[yaml.DefaultPath], err = cfg.MarshalYAML(); err != nil {
+		return nil, nil, fmt.Errorf("unable to marshal the project configuration: %w", err)
+	}
+
+	paths := make([]string, 0, len(contents))
+	for path := range contents {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var changes []fileChange
+	for _, path := range paths {
+		change := fileChange{Path: path, After: contents[path]}
+		before, err := afero.ReadFile(fs.FS, path)
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+			change.Action = actionCreate
+		case err != nil:
+			return nil, nil, err
+		case bytes.Equal(before, change.After):
+			continue
+		case editedFiles[path] || edited[path]:
+			change.Action, change.Before = actionModify, before
+		default:
+			change.Action, change.Before = actionOverwrite, before
+		}
+		changes = append(changes, change)
+	}
+	return layer, changes, nil
+}
+
+// dryRunReport returns the list of the changes, followed by the diffs of the modified files
+func dryRunReport(changes []fileChange) (string, error) {
+	if len(changes) == 0 {
+		return "Dry run, no files would be changed\n", nil
+	}
+
+	list := new(strings.Builder)
+	diffs := new(strings.Builder)
+	for _, change := range changes {
+		fmt.Fprintf(list, "  %-10s %s\n", change.Action, change.Path)
+		if change.Action != actionModify {
+			continue
+		}
+
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        splitLines(change.Before),
+			B:        splitLines(change.After),
+			FromFile: "a/" + change.Path,
+			ToFile:   "b/" + change.Path,
+			Context:  3,
+		})
+		if err != nil {
+			return "", err
+		}
+		diffs.WriteString("\n" + diff)
+	}
+	return "Dry run, the following files would be changed:\n" + list.String() + diffs.String(), nil
+}
+
+// splitLines splits content into lines ending with a newline, which difflib.SplitLines adds an empty line to
+// when the content ends with one
+func splitLines(content []byte) []string {
+	lines := strings.SplitAfter(string(content), "\n")
+	last := len(lines) - 1
+	if lines[last] == "" {
+		return lines[:last]
+	}
+	lines[last] += "\n"
+	return lines
+}
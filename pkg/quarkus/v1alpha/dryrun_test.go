@@ -0,0 +1,157 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v3/pkg/model/resource"
+)
+
+var _ = Describe("dry run", func() {
+	var (
+		memFs      afero.Fs
+		fs         machinery.Filesystem
+		testConfig config.Config
+		out        *bytes.Buffer
+	)
+
+	// dryRun runs scaffold in dry-run mode and returns the report
+	dryRun := func(scaffold func(*reporter, machinery.Filesystem) error) string {
+		r := &reporter{dryRun: true, out: out}
+		Expect(r.scaffold("test", fs, testConfig, func(fs machinery.Filesystem) error {
+			return scaffold(r, fs)
+		})).To(Succeed())
+		Expect(r.report()).To(Succeed())
+		return out.String()
+	}
+
+	BeforeEach(func() {
+		memFs = afero.NewMemMapFs()
+		fs = machinery.Filesystem{FS: memFs}
+		testConfig, _ = config.New(config.Version{Number: 3})
+		Expect(testConfig.SetDomain("example.com")).To(Succeed())
+		out = new(bytes.Buffer)
+	})
+
+	It("reports the changes of the scaffolding without writing them", func() {
+		Expect(afero.WriteFile(memFs, "Makefile", []byte("all: build\n\nBUNDLE_CRDS =\n"), 0644)).To(Succeed())
+		Expect(afero.WriteFile(memFs, "Old.java", []byte("class Old {}\n"), 0644)).To(Succeed())
+		Expect(afero.WriteFile(memFs, "Same.java", []byte("class Same {}\n"), 0644)).To(Succeed())
+		Expect(afero.WriteFile(memFs, "Edited.java", []byte("class Edited {}\n"), 0644)).To(Succeed())
+
+		report := dryRun(func(r *reporter, fs machinery.Filesystem) error {
+			r.edit("Edited.java")
+			for path, content := range map[string]string{
+				"Makefile":          "all: build\n\nBUNDLE_CRDS =\nBUNDLE_CRDS += memcacheds.yml\n",
+				"Old.java":          "class Old { int size; }\n",
+				"Same.java":         "class Same {}\n",
+				"Edited.java":       "class Edited { int size; }\n",
+				"src/main/New.java": "class New {}\n",
+			} {
+				if err := fs.FS.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					return err
+				}
+				if err := afero.WriteFile(fs.FS, path, []byte(content), 0644); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		Expect(report).To(Equal(`Warning: Old.java: the existing file is overwritten
+Dry run, the following files would be changed:
+  modify     Edited.java
+  modify     Makefile
+  overwrite  Old.java
+  create     PROJECT
+  create     src/main/New.java
+
+--- a/Edited.java
++++ b/Edited.java
+@@ -1 +1 @@
+-class Edited {}
++class Edited { int size; }
+
+--- a/Makefile
++++ b/Makefile
+@@ -1,3 +1,4 @@
+ all: build
+ 
+ BUNDLE_CRDS =
++BUNDLE_CRDS += memcacheds.yml
+`))
+
+		makefile, err := afero.ReadFile(memFs, "Makefile")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(makefile)).To(Equal("all: build\n\nBUNDLE_CRDS =\n"))
+		Expect(afero.Exists(memFs, "PROJECT")).To(BeFalse())
+		Expect(afero.Exists(memFs, "src/main/New.java")).To(BeFalse())
+	})
+
+	It("reports that nothing would change", func() {
+		content, err := testConfig.MarshalYAML()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(afero.WriteFile(memFs, "PROJECT", content, 0600)).To(Succeed())
+
+		report := dryRun(func(*reporter, machinery.Filesystem) error { return nil })
+		Expect(report).To(Equal("Dry run, no files would be changed\n"))
+	})
+
+	It("creates no API with --dry-run", func() {
+		initSubcommand := initSubcommand{domain: "example.com", projectName: "memcached-operator"}
+		Expect(initSubcommand.InjectConfig(testConfig)).To(Succeed())
+		Expect(initSubcommand.Scaffold(fs)).To(Succeed())
+		makefile, err := afero.ReadFile(memFs, "Makefile")
+		Expect(err).NotTo(HaveOccurred())
+
+		apiSubcommand := createAPISubcommand{options: createAPIOptions{CRDVersion: "v1"}, report: reporter{dryRun: true}}
+		Expect(apiSubcommand.InjectConfig(testConfig)).To(Succeed())
+		res := resource.Resource{
+			GVK:    resource.GVK{Group: "cache", Domain: "example.com", Version: "v1", Kind: "Memcached"},
+			Plural: "memcacheds",
+		}
+		Expect(apiSubcommand.InjectResource(&res)).To(Succeed())
+
+		project, err := testConfig.MarshalYAML()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(afero.WriteFile(memFs, "PROJECT", project, 0600)).To(Succeed())
+		apiSubcommand.report.out = out
+		Expect(apiSubcommand.Scaffold(fs)).To(Succeed())
+		Expect(afero.Exists(memFs, "src/main/java/com/example/v1/Memcached.java")).To(BeFalse())
+		// The configuration the CLI saves is left unchanged, should the PROJECT file not be restored
+		Expect(testConfig.HasResource(res.GVK)).To(BeFalse())
+		unchanged, err := afero.ReadFile(memFs, "Makefile")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unchanged).To(Equal(makefile))
+
+		// The PROJECT file the CLI saves is restored
+		Expect(afero.WriteFile(memFs, "PROJECT", []byte("version: \"3\"\n"), 0644)).To(Succeed())
+		Expect(apiSubcommand.PostScaffold()).To(Succeed())
+		restored, err := afero.ReadFile(memFs, "PROJECT")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restored).To(Equal(project))
+
+		report := out.String()
+		Expect(report).To(ContainSubstring("  create     src/main/java/com/example/v1/Memcached.java\n"))
+		Expect(report).To(ContainSubstring("  modify     Makefile\n"))
+		Expect(report).To(ContainSubstring("+BUNDLE_CRDS += target/kubernetes/memcacheds.cache.example.com-v1.yml\n"))
+	})
+})
@@ -0,0 +1,308 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/scaffolds"
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/util"
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v3/pkg/plugin"
+)
+
+const (
+	multigroupFlag     = "multigroup"
+	packageFlag        = "package"
+	nativeFlag         = "native"
+	quarkusVersionFlag = "quarkus-version"
+	upgradeFlag        = "upgrade"
+)
+
+type editSubcommand struct {
+	config       config.Config
+	pluginConfig scaffolds.PluginConfig
+
+	// flagSet is used to only apply the settings which were set on the command line
+	flagSet *pflag.FlagSet
+
+	// packageChanged indicates that the Java package of the project was changed
+	packageChanged bool
+
+	// previousConfig holds the settings of the project before the edit, which the sources are upgraded from
+	previousConfig scaffolds.PluginConfig
+
+	report reporter
+
+	// Flags
+	multigroup         bool
+	javaPackage        string
+	native             bool
+	quarkusVersion     string
+	operatorSDKVersion string
+	javaVersion        string
+	upgrade            bool
+}
+
+var (
+	_ plugin.EditSubcommand = &editSubcommand{}
+)
+
+func (p *editSubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
+	subcmdMeta.Description = `Edit the project-wide settings of a project.
+
+Only the settings given on the command line are changed. The pom.xml, Makefile and
+application.properties files are updated to match the new settings. Already existing
+Java sources are not moved when the Java package changes, and the multigroup
+layout cannot be toggled once the project contains resources of a named group.
+
+With --upgrade, the project is moved to the latest versions of Quarkus and
+quarkus-operator-sdk known to work together, unless --quarkus-version or
+--quarkus-sdk-version are set, and to the Java release they require. The
+sources are migrated along: the javax imports are moved to jakarta as of
+Quarkus 3, and the Context parameters of the reconcilers are typed by their
+custom resource as of quarkus-operator-sdk 4, and the reconcilers which clean
+up their custom resources implement Cleaner as of quarkus-operator-sdk 5. The
+files which could not be migrated automatically are reported. Changes requiring such a migration are
+refused without --upgrade.
+`
+	subcmdMeta.Examples = fmt.Sprintf(`  # Enable the multigroup layout
+  %[1]s edit --multigroup
+
+  # Build the operator as a native executable with another Quarkus version
+  %[1]s edit --native --quarkus-version 2.7.6.Final
+
+  # Upgrade the project to the latest known versions and migrate its sources
+  %[1]s edit --upgrade
+
+  # Generate the sources of new APIs into another Java package
+  %[1]s edit --package com.example.operators
+`, cliMeta.CommandName)
+}
+
+func (p *editSubcommand) BindFlags(fs *pflag.FlagSet) {
+	fs.SortFlags = false
+	fs.BoolVar(&p.multigroup, multigroupFlag, false, "enable or disable multigroup layout")
+	fs.StringVar(&p.javaPackage, packageFlag, "", "Java package of the generated sources")
+	fs.BoolVar(&p.native, nativeFlag, false, "enable or disable building the operator as a native executable")
+	fs.StringVar(&p.quarkusVersion, quarkusVersionFlag, "", "Quarkus version to build the project with")
+	fs.StringVar(&p.operatorSDKVersion, quarkusSDKVersionFlag, "",
+		"version of quarkus-operator-sdk, the Quarkus extension of the java-operator-sdk")
+	fs.StringVar(&p.javaVersion, javaVersionFlag, "", "Java release to compile the sources for")
+	fs.BoolVar(&p.upgrade, upgradeFlag, false,
+		"upgrade the project to newer versions, migrating its sources")
+	p.report.bindFlags(fs)
+	p.flagSet = fs
+}
+
+func (p *editSubcommand) InjectConfig(c config.Config) error {
+	if err := p.report.validate(); err != nil {
+		return err
+	}
+	c, err := p.report.isolate(c)
+	if err != nil {
+		return err
+	}
+	p.config = c
+
+	pluginConfig, err := loadPluginConfig(c)
+	if err != nil {
+		return err
+	}
+	p.pluginConfig = pluginConfig
+	p.previousConfig = pluginConfig
+
+	if p.changed(multigroupFlag) {
+		if err := p.updateMultiGroup(); err != nil {
+			return err
+		}
+	}
+
+	if p.changed(packageFlag) {
+		if err := util.ValidatePackage(p.javaPackage); err != nil {
+			return err
+		}
+		p.packageChanged = p.pluginConfig.Package != p.javaPackage
+		p.pluginConfig.Package = p.javaPackage
+	}
+
+	if p.changed(nativeFlag) {
+		p.pluginConfig.Native = p.native
+	}
+
+	versionsChanged := p.upgrade
+	if p.changed(quarkusVersionFlag) {
+		if p.quarkusVersion == "" {
+			return errors.New("quarkus version cannot be empty")
+		}
+		p.pluginConfig.QuarkusVersion = p.quarkusVersion
+		versionsChanged = true
+	}
+	if p.changed(quarkusSDKVersionFlag) {
+		if p.operatorSDKVersion == "" {
+			return errors.New("quarkus-operator-sdk version cannot be empty")
+		}
+		p.pluginConfig.OperatorSDKVersion = p.operatorSDKVersion
+		versionsChanged = true
+	}
+	if p.changed(javaVersionFlag) {
+		if p.javaVersion == "" {
+			return errors.New("java version cannot be empty")
+		}
+		p.pluginConfig.JavaVersion = p.javaVersion
+		versionsChanged = true
+	}
+	if p.upgrade {
+		p.upgradeVersions()
+	}
+
+	if versionsChanged {
+		if err := p.checkVersions(); err != nil {
+			return err
+		}
+	}
+
+	cleanerChanged := p.pluginConfig.HasCleanup() &&
+		p.previousConfig.CleanerInterface() != p.pluginConfig.CleanerInterface()
+	if !p.upgrade && (p.previousConfig.EENamespace() != p.pluginConfig.EENamespace() ||
+		p.previousConfig.GenericContext() != p.pluginConfig.GenericContext() || cleanerChanged) {
+		return fmt.Errorf("the new versions require migrating the sources, use --%s to do so", upgradeFlag)
+	}
+
+	return nil
+}
+
+// upgradeVersions moves the project to the latest known versions, unless others were given,
+// and to the Java release they require
+func (p *editSubcommand) upgradeVersions() {
+	if !p.changed(quarkusVersionFlag) && !p.changed(quarkusSDKVersionFlag) {
+		p.pluginConfig.QuarkusVersion = scaffolds.LatestQuarkusVersion
+		p.pluginConfig.OperatorSDKVersion = scaffolds.LatestOperatorSDKVersion
+	}
+
+	if !p.changed(javaVersionFlag) {
+		javaVersion := p.pluginConfig.JavaVersion
+		if javaVersion == "" {
+			javaVersion = scaffolds.DefaultJavaVersion
+		}
+		required := scaffolds.RequiredJavaVersion(p.pluginConfig.QuarkusVersion, p.pluginConfig.OperatorSDKVersion)
+		if current, err := strconv.Atoi(javaVersion); err != nil || current < required {
+			javaVersion = strconv.Itoa(required)
+		}
+		p.pluginConfig.JavaVersion = javaVersion
+	}
+}
+
+func (p *editSubcommand) Scaffold(fs machinery.Filesystem) error {
+	return p.report.scaffold("edit", fs, p.config, p.scaffold)
+}
+
+// scaffold updates the files of the project to fs, migrating its sources on upgrades
+func (p *editSubcommand) scaffold(fs machinery.Filesystem) error {
+	scaffolder := scaffolds.NewEditScaffolder(p.config, p.pluginConfig)
+	scaffolder.InjectFS(fs)
+	if err := scaffolder.Scaffold(); err != nil {
+		return err
+	}
+
+	if p.upgrade {
+		upgrader := scaffolds.NewUpgradeScaffolder(p.previousConfig, p.pluginConfig)
+		upgrader.InjectFS(fs)
+		if err := upgrader.Scaffold(); err != nil {
+			return err
+		}
+		for _, issue := range upgrader.Issues() {
+			p.report.warn(diagnosticMigration, issue.Path, issue.Reason)
+		}
+		p.report.edit(upgrader.Edited()...)
+	}
+
+	if p.packageChanged {
+		p.report.warn(diagnosticMigration, "", fmt.Sprintf("the sources of new APIs will be generated into "+
+			"package %s, existing sources have to be moved manually", p.pluginConfig.Package))
+	}
+
+	return savePluginConfig(p.config, p.pluginConfig)
+}
+
+func (p *editSubcommand) PostScaffold() error {
+	if p.upgrade {
+		p.report.inform("Upgraded the project to Quarkus %s, quarkus-operator-sdk %s and Java %s",
+			p.pluginConfig.QuarkusVersion, p.pluginConfig.OperatorSDKVersion, p.pluginConfig.JavaVersion)
+	}
+	return p.report.report()
+}
+
+// checkVersions checks that the new versions of the project work together
+func (p *editSubcommand) checkVersions() error {
+	quarkusVersion := p.pluginConfig.QuarkusVersion
+	operatorSDKVersion, javaVersion := p.pluginConfig.OperatorSDKVersion, p.pluginConfig.JavaVersion
+	if quarkusVersion == "" {
+		quarkusVersion = scaffolds.DefaultQuarkusVersion
+	}
+	if operatorSDKVersion == "" {
+		operatorSDKVersion = scaffolds.DefaultOperatorSDKVersion
+	}
+	if javaVersion == "" {
+		javaVersion = scaffolds.DefaultJavaVersion
+	}
+
+	warning, err := scaffolds.CheckVersions(quarkusVersion, operatorSDKVersion, javaVersion)
+	if err != nil {
+		return fmt.Errorf("unsupported versions: %w", err)
+	}
+	if warning != "" {
+		p.report.warn(diagnosticVersion, "", warning)
+	}
+	return nil
+}
+
+// changed returns whether the flag was set on the command line
+func (p *editSubcommand) changed(name string) bool {
+	return p.flagSet != nil && p.flagSet.Changed(name)
+}
+
+// updateMultiGroup toggles the multigroup layout, which qualifies the packages by the group. It is refused once the
+// project contains resources of a named group, whose sources would have to move to other packages.
+func (p *editSubcommand) updateMultiGroup() error {
+	if p.multigroup == p.config.IsMultiGroup() {
+		return nil
+	}
+
+	resources, err := p.config.GetResources()
+	if err != nil {
+		return err
+	}
+	for _, res := range resources {
+		if res.Group != "" {
+			state := "disabled"
+			if p.multigroup {
+				state = "enabled"
+			}
+			return fmt.Errorf("multigroup cannot be %s, the project already contains resources of group %q "+
+				"whose sources would have to move to other packages", state, res.Group)
+		}
+	}
+
+	if p.multigroup {
+		return p.config.SetMultiGroup()
+	}
+	return p.config.ClearMultiGroup()
+}
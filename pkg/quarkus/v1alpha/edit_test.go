@@ -0,0 +1,176 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	"sigs.k8s.io/kubebuilder/v3/pkg/model/resource"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/scaffolds"
+)
+
+var _ = Describe("v1", func() {
+	var (
+		testEditSubcommand editSubcommand
+		testConfig         config.Config
+		flagSet            *pflag.FlagSet
+	)
+
+	BeforeEach(func() {
+		testEditSubcommand = editSubcommand{}
+		flagSet = pflag.NewFlagSet("testFlag", pflag.ContinueOnError)
+		testEditSubcommand.BindFlags(flagSet)
+
+		testConfig, _ = config.New(config.Version{Number: 3})
+		Expect(testConfig.SetDomain("example.com")).To(Succeed())
+		Expect(savePluginConfig(testConfig, scaffolds.PluginConfig{
+			Package:        "com.example",
+			QuarkusVersion: "2.7.5.Final",
+		})).To(Succeed())
+	})
+
+	Describe("BindFlags", func() {
+		It("should set the default values", func() {
+			Expect(flagSet.SortFlags).To(BeFalse())
+			Expect(testEditSubcommand.multigroup).To(BeFalse())
+			Expect(testEditSubcommand.javaPackage).To(Equal(""))
+			Expect(testEditSubcommand.native).To(BeFalse())
+			Expect(testEditSubcommand.quarkusVersion).To(Equal(""))
+			Expect(testEditSubcommand.operatorSDKVersion).To(Equal(""))
+			Expect(testEditSubcommand.javaVersion).To(Equal(""))
+			Expect(testEditSubcommand.upgrade).To(BeFalse())
+		})
+	})
+
+	Describe("InjectConfig", func() {
+		It("should only change the settings given on the command line", func() {
+			Expect(flagSet.Parse([]string{"--native"})).To(Succeed())
+			Expect(testEditSubcommand.InjectConfig(testConfig)).To(Succeed())
+			Expect(testEditSubcommand.pluginConfig).To(Equal(scaffolds.PluginConfig{
+				Package:        "com.example",
+				QuarkusVersion: "2.7.5.Final",
+				Native:         true,
+			}))
+			Expect(testConfig.IsMultiGroup()).To(BeFalse())
+		})
+
+		It("should change the package and the Quarkus version", func() {
+			Expect(flagSet.Parse([]string{"--package", "com.acme.operators", "--quarkus-version", "2.7.6.Final"})).To(Succeed())
+			Expect(testEditSubcommand.InjectConfig(testConfig)).To(Succeed())
+			Expect(testEditSubcommand.pluginConfig.Package).To(Equal("com.acme.operators"))
+			Expect(testEditSubcommand.pluginConfig.QuarkusVersion).To(Equal("2.7.6.Final"))
+			Expect(testEditSubcommand.packageChanged).To(BeTrue())
+		})
+
+		It("should reject a Quarkus version incompatible with the quarkus-operator-sdk version", func() {
+			Expect(flagSet.Parse([]string{"--quarkus-version", "3.8.4"})).To(Succeed())
+			Expect(testEditSubcommand.InjectConfig(testConfig)).To(MatchError(ContainSubstring("unsupported versions")))
+		})
+
+		It("should upgrade to the latest known versions and the Java release they require", func() {
+			Expect(flagSet.Parse([]string{"--upgrade"})).To(Succeed())
+			Expect(testEditSubcommand.InjectConfig(testConfig)).To(Succeed())
+			Expect(testEditSubcommand.pluginConfig.QuarkusVersion).To(Equal(scaffolds.LatestQuarkusVersion))
+			Expect(testEditSubcommand.pluginConfig.OperatorSDKVersion).To(Equal(scaffolds.LatestOperatorSDKVersion))
+			Expect(testEditSubcommand.pluginConfig.JavaVersion).To(Equal("17"))
+			Expect(testEditSubcommand.previousConfig.QuarkusVersion).To(Equal("2.7.5.Final"))
+		})
+
+		It("should upgrade to the given versions", func() {
+			Expect(flagSet.Parse([]string{"--upgrade", "--quarkus-version", "2.16.12.Final", "--quarkus-sdk-version", "5.1.5"})).To(Succeed())
+			Expect(testEditSubcommand.InjectConfig(testConfig)).To(Succeed())
+			Expect(testEditSubcommand.pluginConfig.QuarkusVersion).To(Equal("2.16.12.Final"))
+			Expect(testEditSubcommand.pluginConfig.OperatorSDKVersion).To(Equal("5.1.5"))
+			Expect(testEditSubcommand.pluginConfig.JavaVersion).To(Equal("11"))
+		})
+
+		It("should refuse versions requiring a migration of the sources without --upgrade", func() {
+			Expect(flagSet.Parse([]string{"--quarkus-version", "3.8.4", "--quarkus-sdk-version", "6.6.4", "--java-version", "17"})).To(Succeed())
+			Expect(testEditSubcommand.InjectConfig(testConfig)).To(MatchError(ContainSubstring("--upgrade")))
+		})
+
+		It("should refuse moving the reconcilers which clean up to Cleaner without --upgrade", func() {
+			pluginConfig := scaffolds.PluginConfig{
+				Package:            "com.example",
+				QuarkusVersion:     "2.11.3.Final",
+				OperatorSDKVersion: "4.0.5",
+			}
+			Expect(savePluginConfig(testConfig, pluginConfig)).To(Succeed())
+			args := []string{"--quarkus-version", "2.13.9.Final", "--quarkus-sdk-version", "5.0.4"}
+			Expect(flagSet.Parse(args)).To(Succeed())
+			Expect(testEditSubcommand.InjectConfig(testConfig)).To(Succeed())
+
+			pluginConfig.Reconcilers = map[string]scaffolds.ReconcilerOptions{
+				"memcacheds.cache.example.com": {Cleanup: true},
+			}
+			Expect(savePluginConfig(testConfig, pluginConfig)).To(Succeed())
+			Expect(testEditSubcommand.InjectConfig(testConfig)).To(MatchError(ContainSubstring("--upgrade")))
+		})
+
+		It("should reject an illegal package", func() {
+			Expect(flagSet.Parse([]string{"--package", "com.acme.my-operator"})).To(Succeed())
+			Expect(testEditSubcommand.InjectConfig(testConfig)).NotTo(Succeed())
+		})
+
+		It("should toggle multigroup", func() {
+			Expect(flagSet.Parse([]string{"--multigroup"})).To(Succeed())
+			Expect(testEditSubcommand.InjectConfig(testConfig)).To(Succeed())
+			Expect(testConfig.IsMultiGroup()).To(BeTrue())
+
+			Expect(flagSet.Parse([]string{"--multigroup=false"})).To(Succeed())
+			Expect(testEditSubcommand.InjectConfig(testConfig)).To(Succeed())
+			Expect(testConfig.IsMultiGroup()).To(BeFalse())
+		})
+
+		It("should not disable multigroup when there are several groups", func() {
+			Expect(testConfig.SetMultiGroup()).To(Succeed())
+			for _, group := range []string{"cache", "apps"} {
+				Expect(testConfig.AddResource(resource.Resource{
+					GVK:    resource.GVK{Group: group, Domain: "example.com", Version: "v1", Kind: "Memcached"},
+					Plural: "memcacheds",
+				})).To(Succeed())
+			}
+
+			Expect(flagSet.Parse([]string{"--multigroup=false"})).To(Succeed())
+			Expect(testEditSubcommand.InjectConfig(testConfig)).NotTo(Succeed())
+			Expect(testConfig.IsMultiGroup()).To(BeTrue())
+		})
+
+		It("should not enable multigroup when there are resources, whose packages would change", func() {
+			Expect(testConfig.AddResource(resource.Resource{
+				GVK:    resource.GVK{Group: "cache", Domain: "example.com", Version: "v1", Kind: "Memcached"},
+				Plural: "memcacheds",
+			})).To(Succeed())
+
+			Expect(flagSet.Parse([]string{"--multigroup"})).To(Succeed())
+			Expect(testEditSubcommand.InjectConfig(testConfig)).To(MatchError(ContainSubstring(
+				"multigroup cannot be enabled")))
+			Expect(testConfig.IsMultiGroup()).To(BeFalse())
+
+			// Keeping the layout is a no-op
+			Expect(flagSet.Parse([]string{"--multigroup=false"})).To(Succeed())
+			Expect(testEditSubcommand.InjectConfig(testConfig)).To(Succeed())
+		})
+	})
+
+	Describe("PostScaffold", func() {
+		It("should return nil", func() {
+			Expect(testEditSubcommand.PostScaffold()).To(BeNil())
+		})
+	})
+})
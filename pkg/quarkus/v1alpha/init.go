@@ -21,11 +21,14 @@ import (
 	"strings"
 
 	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/scaffolds"
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/util"
+	"github.com/spf13/afero"
 	"github.com/spf13/pflag"
 	"k8s.io/apimachinery/pkg/util/validation"
 
 	"sigs.k8s.io/kubebuilder/v3/pkg/config"
 	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v3/pkg/model/resource"
 	"sigs.k8s.io/kubebuilder/v3/pkg/plugin"
 )
 
@@ -35,12 +38,30 @@ const (
 	groupFlag   = "group"
 	versionFlag = "version"
 	kindFlag    = "kind"
+
+	mainClassFlag  = "main-class"
+	buildToolFlag  = "build-tool"
+	languageFlag   = "language"
+	groupIDFlag    = "group-id"
+	artifactIDFlag = "artifact-id"
+
+	quarkusSDKVersionFlag = "quarkus-sdk-version"
+	javaVersionFlag       = "java-version"
+	operatorVersionFlag   = "operator-version"
+
+	forceFlag = "force"
 )
 
+// projectFiles are the files and directories telling that a directory already holds a Java project
+var projectFiles = []string{
+	"pom.xml", "build.gradle", "build.gradle.kts", filepath.Join("src", "main", "java"), filepath.Join("src", "main", "kotlin"),
+}
+
 type initSubcommand struct {
 	apiSubcommand createAPISubcommand
 
-	config config.Config
+	config       config.Config
+	pluginConfig scaffolds.PluginConfig
 
 	// For help text.
 	commandName string
@@ -51,6 +72,20 @@ type initSubcommand struct {
 	version     string
 	kind        string
 	projectName string
+	mainClass   bool
+	buildTool   string
+	language    string
+	javaPackage string
+	groupID     string
+	artifactID  string
+
+	quarkusVersion     string
+	operatorSDKVersion string
+	javaVersion        string
+	operatorVersion    string
+
+	// force initializes the project even if the directory is not empty, overwriting the files of the plugin
+	force bool
 }
 
 var (
@@ -62,8 +97,62 @@ func (p *initSubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *
 
 Writes the following files:
 - a basic, Quarkus-based operator set-up
-- a pom.xml file to build the project with Maven
+- a pom.xml file to build the project with Maven, or build.gradle.kts,
+  settings.gradle.kts and gradle.properties files if --build-tool=gradle is set
+- the Kotlin compiler set-up if --language=kotlin is set, in which case the
+  main class is written in Kotlin, as are the models and reconcilers
+  "create api" generates
+- a main class starting the operator, unless --main-class=false is set to let
+  Quarkus start the operator implicitly
+
+The project is built with Quarkus ` + scaffolds.DefaultQuarkusVersion + `, quarkus-operator-sdk ` + scaffolds.DefaultOperatorSDKVersion + `
+and Java ` + scaffolds.DefaultJavaVersion + ` unless --quarkus-version, --quarkus-sdk-version and --java-version are
+set. The combination is checked against the versions known to work together:
+init fails if they are known not to, and warns if the combination is unknown.
+As of Quarkus 3, the sources import the jakarta packages instead of the javax ones.
+
+The Java package of the generated sources is derived from the domain, e.g.
+com.example for example.com, unless --package is set. The Maven group ID of the
+project is the package and its artifact ID the project name, unless --group-id
+and --artifact-id are set.
+
+If --group, --version and --kind are set, the API is created in the same run,
+as if running "create api" right after init.
+
+The project is only initialized in an empty directory, git metadata aside, and
+never over an existing Java project. Set --force to initialize it anyway, in
+which case the files scaffolded by init are overwritten.
+
+With --dry-run, the files that would be created, overwritten or modified are
+listed along with the diffs of the edited ones, and nothing is written, not
+even the PROJECT file.
+
+The command ends with a summary of the changed files and the next steps,
+which --output=json prints as a JSON document along with the warnings.
 `
+	subcmdMeta.Examples = fmt.Sprintf(`  # Initialize a new project
+  %[1]s init --domain example.com
+
+  # Initialize a new project generating its sources into another Java package
+  %[1]s init --domain example.com --package com.acme.platform.operators.memcached \
+    --group-id com.acme.platform --artifact-id memcached-operator
+
+  # Initialize a new project built with Quarkus 3 and Java 17
+  %[1]s init --domain example.com --quarkus-version 3.8.4 --quarkus-sdk-version 6.6.4 --java-version 17
+
+  # Initialize a new project built with Gradle
+  %[1]s init --domain example.com --build-tool gradle
+
+  # Initialize a new project generating Kotlin models and reconcilers
+  %[1]s init --domain example.com --language kotlin
+
+  # Initialize a new project and create its first API
+  %[1]s init --domain example.com --group cache --version v1 --kind Memcached
+
+  # Show the files a new project would have without writing them
+  %[1]s init --domain example.com --dry-run
+`, cliMeta.CommandName)
+
 	p.commandName = cliMeta.CommandName
 }
 
@@ -73,6 +162,24 @@ func (p *initSubcommand) BindFlags(fs *pflag.FlagSet) {
 	fs.SortFlags = false
 	fs.StringVar(&p.domain, "domain", "my.domain", "domain for groups")
 	fs.StringVar(&p.projectName, "project-name", "", "name of this project, the default being directory name")
+	fs.StringVar(&p.javaPackage, packageFlag, "", "Java package of the generated sources, the default being derived from the domain")
+	fs.StringVar(&p.groupID, groupIDFlag, "", "Maven group ID of the project, the default being the Java package")
+	fs.StringVar(&p.artifactID, artifactIDFlag, "", "Maven artifact ID of the project, the default being the project name")
+	fs.StringVar(&p.operatorVersion, operatorVersionFlag, scaffolds.DefaultOperatorVersion, "version of the operator")
+	fs.StringVar(&p.quarkusVersion, quarkusVersionFlag, scaffolds.DefaultQuarkusVersion, "Quarkus version to build the project with")
+	fs.StringVar(&p.operatorSDKVersion, quarkusSDKVersionFlag, scaffolds.DefaultOperatorSDKVersion,
+		"version of quarkus-operator-sdk, the Quarkus extension of the java-operator-sdk")
+	fs.StringVar(&p.javaVersion, javaVersionFlag, scaffolds.DefaultJavaVersion, "Java release to compile the sources for")
+	fs.BoolVar(&p.mainClass, mainClassFlag, true,
+		"generate a main class starting the operator, set to false to let Quarkus start the operator implicitly")
+	fs.StringVar(&p.buildTool, buildToolFlag, scaffolds.BuildToolMaven,
+		fmt.Sprintf("tool to build the project with, either %q or %q", scaffolds.BuildToolMaven, scaffolds.BuildToolGradle))
+	fs.StringVar(&p.language, languageFlag, scaffolds.LanguageJava,
+		fmt.Sprintf("language to generate the main class, the models and the reconcilers in, either %q or %q", scaffolds.LanguageJava, scaffolds.LanguageKotlin))
+
+	fs.BoolVar(&p.force, forceFlag, false,
+		"initialize the project even if the directory is not empty or already holds a Java project, "+
+			"overwriting the files scaffolded by init")
 
 	fs.StringVar(&p.group, groupFlag, "", "resource Group")
 	fs.StringVar(&p.version, versionFlag, "", "resource Version")
@@ -81,44 +188,273 @@ func (p *initSubcommand) BindFlags(fs *pflag.FlagSet) {
 }
 
 func (p *initSubcommand) InjectConfig(c config.Config) error {
+	if err := p.reporter().validate(); err != nil {
+		return err
+	}
+	c, err := p.reporter().isolate(c)
+	if err != nil {
+		return err
+	}
 	p.config = c
 
 	if err := p.config.SetDomain(p.domain); err != nil {
 		return err
 	}
 
-	// Assign a default project name
-	if p.projectName == "" {
-		dir, err := os.Getwd()
-		if err != nil {
-			return fmt.Errorf("error getting current directory: %v", err)
+	// The default project name is only known once the filesystem the project is scaffolded into is
+	if p.projectName != "" {
+		if err := p.setProjectName(); err != nil {
+			return err
+		}
+	}
+
+	switch p.buildTool {
+	case "":
+		p.buildTool = scaffolds.BuildToolMaven
+	case scaffolds.BuildToolMaven, scaffolds.BuildToolGradle:
+	default:
+		return fmt.Errorf("invalid --%s %q, expected %q or %q",
+			buildToolFlag, p.buildTool, scaffolds.BuildToolMaven, scaffolds.BuildToolGradle)
+	}
+
+	switch p.language {
+	case "":
+		p.language = scaffolds.LanguageJava
+	case scaffolds.LanguageJava, scaffolds.LanguageKotlin:
+	default:
+		return fmt.Errorf("invalid --%s %q, expected %q or %q",
+			languageFlag, p.language, scaffolds.LanguageJava, scaffolds.LanguageKotlin)
+	}
+
+	javaPackage := p.javaPackage
+	if javaPackage == "" {
+		var warnings []util.Warning
+		javaPackage, warnings = util.SanitizePackage(util.ReverseDomain(p.config.GetDomain()))
+		p.reporter().warnNames(warnings...)
+	} else if err := util.ValidatePackage(javaPackage); err != nil {
+		return err
+	}
+	if p.groupID != "" {
+		if err := util.ValidateMavenID(p.groupID); err != nil {
+			return fmt.Errorf("invalid --%s: %w", groupIDFlag, err)
 		}
-		p.projectName = strings.ToLower(filepath.Base(dir))
 	}
-	// Check if the project name is a valid k8s namespace (DNS 1123 label).
+	if p.artifactID != "" {
+		if err := util.ValidateMavenID(p.artifactID); err != nil {
+			return fmt.Errorf("invalid --%s: %w", artifactIDFlag, err)
+		}
+	}
+
+	if err := p.checkVersions(); err != nil {
+		return err
+	}
+
+	p.pluginConfig = scaffolds.PluginConfig{
+		Package:            javaPackage,
+		GroupID:            p.groupID,
+		ArtifactID:         p.artifactID,
+		QuarkusVersion:     p.quarkusVersion,
+		OperatorSDKVersion: p.operatorSDKVersion,
+		JavaVersion:        p.javaVersion,
+		OperatorVersion:    p.operatorVersion,
+		MainClass:          p.mainClass,
+		BuildTool:          p.buildTool,
+		Language:           p.language,
+	}
+	if err := savePluginConfig(p.config, p.pluginConfig); err != nil {
+		return err
+	}
+
+	if p.hasAPI() {
+		if err := p.injectAPI(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setProjectName records the project name, which must be a valid k8s namespace (DNS 1123 label)
+func (p *initSubcommand) setProjectName() error {
 	if err := validation.IsDNS1123Label(p.projectName); err != nil {
 		return fmt.Errorf("project name (%s) is invalid: %v", p.projectName, err)
 	}
-	if err := p.config.SetProjectName(p.projectName); err != nil {
-		return err
+	if p.mainClass {
+		if _, warning := scaffolds.OperatorClassName(p.projectName); warning != nil {
+			p.reporter().warnNames(*warning)
+		}
+	}
+	return p.config.SetProjectName(p.projectName)
+}
+
+// defaultProjectName returns the name of the directory the filesystem is rooted at
+func defaultProjectName(fs afero.Fs) (string, error) {
+	dir := "."
+	if base, ok := fs.(*afero.BasePathFs); ok {
+		var err error
+		if dir, err = base.RealPath("."); err != nil {
+			return "", err
+		}
 	}
 
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", fmt.Errorf("error getting the project directory: %v", err)
+	}
+	return strings.ToLower(filepath.Base(dir)), nil
+}
+
+// reporter returns the reporter of the subcommand, which is the one of the API subcommand binding the --dry-run
+// and --output flags
+func (p *initSubcommand) reporter() *reporter {
+	return &p.apiSubcommand.report
+}
+
+// checkVersions defaults the versions which are not set and checks that they work together
+func (p *initSubcommand) checkVersions() error {
+	if p.quarkusVersion == "" {
+		p.quarkusVersion = scaffolds.DefaultQuarkusVersion
+	}
+	if p.operatorSDKVersion == "" {
+		p.operatorSDKVersion = scaffolds.DefaultOperatorSDKVersion
+	}
+	if p.javaVersion == "" {
+		p.javaVersion = scaffolds.DefaultJavaVersion
+	}
+	if p.operatorVersion == "" {
+		p.operatorVersion = scaffolds.DefaultOperatorVersion
+	}
+
+	if err := util.ValidateMavenID(p.operatorVersion); err != nil {
+		return fmt.Errorf("invalid --%s: %w", operatorVersionFlag, err)
+	}
+	if strings.HasSuffix(p.operatorVersion, "-SNAPSHOT") {
+		return fmt.Errorf("invalid --%s %q: the -SNAPSHOT suffix is added by the build", operatorVersionFlag, p.operatorVersion)
+	}
+
+	warning, err := scaffolds.CheckVersions(p.quarkusVersion, p.operatorSDKVersion, p.javaVersion)
+	if err != nil {
+		return fmt.Errorf("unsupported versions: %w", err)
+	}
+	if warning != "" {
+		p.reporter().warn(diagnosticVersion, "", warning)
+	}
 	return nil
 }
 
-func (p *initSubcommand) Validate() error {
-	// TODO: validate the conditions you expect before running the plugin
+// hasAPI returns whether an API should be created along with the project
+func (p *initSubcommand) hasAPI() bool {
+	return p.group != "" || p.version != "" || p.kind != ""
+}
+
+// injectAPI prepares the create api subcommand for the resource given to init
+func (p *initSubcommand) injectAPI() error {
+	if p.version == "" || p.kind == "" {
+		return fmt.Errorf("--%s and --%s are required to create an API on init", versionFlag, kindFlag)
+	}
+
+	if err := p.apiSubcommand.InjectConfig(p.config); err != nil {
+		return err
+	}
+
+	res := &resource.Resource{
+		GVK: resource.GVK{
+			Group:   strings.TrimSpace(p.group),
+			Domain:  p.config.GetDomain(),
+			Version: strings.TrimSpace(p.version),
+			Kind:    strings.TrimSpace(p.kind),
+		},
+		Plural:   resource.RegularPlural(strings.TrimSpace(p.kind)),
+		API:      &resource.API{},
+		Webhooks: &resource.Webhooks{},
+	}
+	return p.apiSubcommand.InjectResource(res)
+}
+
+// PreScaffold implements plugin.HasPreScaffold
+func (p *initSubcommand) PreScaffold(fs machinery.Filesystem) error {
+	return p.Validate(fs)
+}
+
+// Validate refuses to initialize the project in a directory which is not empty, unless --force is set
+func (p *initSubcommand) Validate(fs machinery.Filesystem) error {
+	if p.force {
+		return nil
+	}
+
+	var found []string
+	for _, path := range projectFiles {
+		exists, err := afero.Exists(fs.FS, path)
+		if err != nil {
+			return fmt.Errorf("error checking for %s: %v", path, err)
+		}
+		if exists {
+			found = append(found, path)
+		}
+	}
+	if len(found) > 0 {
+		return fmt.Errorf("the directory already holds a Java project (found %s), "+
+			"set --%s to overwrite the files scaffolded by init", strings.Join(found, ", "), forceFlag)
+	}
+
+	entries, err := afero.ReadDir(fs.FS, ".")
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("error reading the project directory: %v", err)
+	}
+	found = nil
+	for _, entry := range entries {
+		// The metadata of a freshly created git repository do not stand in the way of the project
+		if entry.Name() != ".git" {
+			found = append(found, entry.Name())
+		}
+	}
+	if len(found) > 0 {
+		return fmt.Errorf("the directory is not empty (found %s), "+
+			"set --%s to initialize the project anyway, overwriting the files scaffolded by init",
+			strings.Join(found, ", "), forceFlag)
+	}
+
 	return nil
 }
 
 func (p *initSubcommand) PostScaffold() error {
+	if p.hasAPI() {
+		return p.reporter().report(p.apiSubcommand.nextSteps()...)
+	}
+
 	// print follow on instructions to better guide the user
-	fmt.Printf("Next: define a resource with:\n$ %s create api\n", p.commandName)
-	return nil
+	return p.reporter().report(nextStep{Description: "define a resource", Command: p.commandName + " create api"})
 }
 
 func (p *initSubcommand) Scaffold(fs machinery.Filesystem) error {
-	scaffolder := scaffolds.NewInitScaffolder(p.config)
+	if p.projectName == "" {
+		projectName, err := defaultProjectName(fs.FS)
+		if err != nil {
+			return err
+		}
+		p.projectName = projectName
+		if err := p.setProjectName(); err != nil {
+			return err
+		}
+	}
+
+	// The changes of the API scaffolded along with the project are reported along with the ones of init
+	return p.reporter().scaffold("init", fs, p.config, p.scaffold)
+}
+
+// scaffold writes the files of the project, and of its first API if any, to fs
+func (p *initSubcommand) scaffold(fs machinery.Filesystem) error {
+	scaffolder := scaffolds.NewInitScaffolder(p.config, p.pluginConfig, p.force)
 	scaffolder.InjectFS(fs)
-	return scaffolder.Scaffold()
+	if err := scaffolder.Scaffold(); err != nil {
+		return err
+	}
+
+	if p.hasAPI() {
+		return p.apiSubcommand.scaffold(fs)
+	}
+
+	return nil
 }
@@ -15,15 +15,19 @@
 package v1
 
 import (
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/scaffolds"
+	"github.com/spf13/afero"
 	"github.com/spf13/pflag"
 
 	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v3/pkg/plugin"
 )
 
@@ -66,6 +70,16 @@ var _ = Describe("v1", func() {
 			Expect(successInitSubcommand.group).To(Equal(""))
 			Expect(successInitSubcommand.version).To(Equal(""))
 			Expect(successInitSubcommand.kind).To(Equal(""))
+			Expect(successInitSubcommand.mainClass).To(BeTrue())
+			Expect(successInitSubcommand.buildTool).To(Equal("maven"))
+			Expect(successInitSubcommand.language).To(Equal("java"))
+			Expect(successInitSubcommand.javaPackage).To(Equal(""))
+			Expect(successInitSubcommand.groupID).To(Equal(""))
+			Expect(successInitSubcommand.artifactID).To(Equal(""))
+			Expect(successInitSubcommand.quarkusVersion).To(Equal(scaffolds.DefaultQuarkusVersion))
+			Expect(successInitSubcommand.operatorSDKVersion).To(Equal(scaffolds.DefaultOperatorSDKVersion))
+			Expect(successInitSubcommand.javaVersion).To(Equal(scaffolds.DefaultJavaVersion))
+			Expect(successInitSubcommand.operatorVersion).To(Equal(scaffolds.DefaultOperatorVersion))
 		})
 	})
 
@@ -82,11 +96,348 @@ var _ = Describe("v1", func() {
 			Expect(successInitSubcommand.projectName, testConfig.GetProjectName())
 			Expect(successInitSubcommand.InjectConfig(testConfig)).To(BeNil())
 		})
+
+		It("should prepare the API when a resource is given", func() {
+			testConfig, _ := config.New(config.Version{Number: 3})
+			apiInitSubcommand := initSubcommand{
+				domain:      "example.com",
+				projectName: "memcached-operator",
+				group:       "cache",
+				version:     "v1",
+				kind:        "Memcached",
+			}
+			apiInitSubcommand.apiSubcommand.BindFlags(pflag.NewFlagSet("testFlag", -1))
+
+			Expect(apiInitSubcommand.InjectConfig(testConfig)).To(Succeed())
+			Expect(apiInitSubcommand.apiSubcommand.resource).NotTo(BeNil())
+			Expect(apiInitSubcommand.apiSubcommand.resource.QualifiedGroup()).To(Equal("cache.example.com"))
+			Expect(apiInitSubcommand.apiSubcommand.resource.Plural).To(Equal("memcacheds"))
+			Expect(apiInitSubcommand.apiSubcommand.resource.HasAPI()).To(BeTrue())
+			Expect(apiInitSubcommand.apiSubcommand.pluginConfig.Package).To(Equal("com.example"))
+		})
+
+		It("should record whether the main class is scaffolded", func() {
+			testConfig, _ := config.New(config.Version{Number: 3})
+			successInitSubcommand.mainClass = true
+			Expect(successInitSubcommand.InjectConfig(testConfig)).To(Succeed())
+
+			pluginConfig, err := loadPluginConfig(testConfig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pluginConfig.MainClass).To(BeTrue())
+		})
+
+		It("should record the settings in the PROJECT file", func() {
+			testConfig, _ := config.New(config.Version{Number: 3})
+			Expect(successInitSubcommand.InjectConfig(testConfig)).To(Succeed())
+
+			project, err := testConfig.MarshalYAML()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(project)).To(ContainSubstring("quarkus.javaoperatorsdk.io/v1-alpha:"))
+
+			pluginConfig, err := loadPluginConfig(testConfig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pluginConfig.QuarkusVersion).To(Equal(scaffolds.DefaultQuarkusVersion))
+			Expect(pluginConfig.OperatorSDKVersion).To(Equal(scaffolds.DefaultOperatorSDKVersion))
+			Expect(pluginConfig.JavaVersion).To(Equal(scaffolds.DefaultJavaVersion))
+			Expect(pluginConfig.OperatorVersion).To(Equal(scaffolds.DefaultOperatorVersion))
+			Expect(pluginConfig.BuildTool).To(Equal(scaffolds.BuildToolMaven))
+			Expect(pluginConfig.Language).To(Equal(scaffolds.LanguageJava))
+		})
+
+		It("should record the package and the Maven coordinates", func() {
+			testConfig, _ := config.New(config.Version{Number: 3})
+			packageInitSubcommand := initSubcommand{
+				domain:      "example.com",
+				projectName: "memcached-operator",
+				javaPackage: "com.acme.platform.operators.memcached",
+				groupID:     "com.acme.platform",
+				artifactID:  "memcached",
+			}
+			Expect(packageInitSubcommand.InjectConfig(testConfig)).To(Succeed())
+
+			pluginConfig, err := loadPluginConfig(testConfig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pluginConfig.Package).To(Equal("com.acme.platform.operators.memcached"))
+			Expect(pluginConfig.MavenGroupID()).To(Equal("com.acme.platform"))
+			Expect(pluginConfig.MavenArtifactID("memcached-operator")).To(Equal("memcached"))
+		})
+
+		It("should default the Maven coordinates to the package and the project name", func() {
+			testConfig, _ := config.New(config.Version{Number: 3})
+			packageInitSubcommand := initSubcommand{
+				domain:      "example.com",
+				projectName: "memcached-operator",
+				javaPackage: "com.acme.operators",
+			}
+			Expect(packageInitSubcommand.InjectConfig(testConfig)).To(Succeed())
+
+			pluginConfig, err := loadPluginConfig(testConfig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pluginConfig.MavenGroupID()).To(Equal("com.acme.operators"))
+			Expect(pluginConfig.MavenArtifactID("memcached-operator")).To(Equal("memcached-operator"))
+		})
+
+		It("should reject an illegal package or Maven coordinates", func() {
+			testConfig, _ := config.New(config.Version{Number: 3})
+			packageInitSubcommand := initSubcommand{
+				domain:      "example.com",
+				projectName: "memcached-operator",
+				javaPackage: "com.acme.int",
+			}
+			Expect(packageInitSubcommand.InjectConfig(testConfig)).To(MatchError(ContainSubstring("Java keyword")))
+
+			packageInitSubcommand.javaPackage = ""
+			packageInitSubcommand.groupID = "com acme"
+			Expect(packageInitSubcommand.InjectConfig(testConfig)).To(MatchError(ContainSubstring("--group-id")))
+
+			packageInitSubcommand.groupID = ""
+			packageInitSubcommand.artifactID = "memcached/operator"
+			Expect(packageInitSubcommand.InjectConfig(testConfig)).To(MatchError(ContainSubstring("--artifact-id")))
+		})
+
+		It("should record the given versions", func() {
+			testConfig, _ := config.New(config.Version{Number: 3})
+			versionInitSubcommand := initSubcommand{
+				domain:             "example.com",
+				projectName:        "memcached-operator",
+				quarkusVersion:     "3.8.4",
+				operatorSDKVersion: "6.6.4",
+				javaVersion:        "17",
+				operatorVersion:    "1.2.0",
+			}
+			Expect(versionInitSubcommand.InjectConfig(testConfig)).To(Succeed())
+
+			pluginConfig, err := loadPluginConfig(testConfig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pluginConfig.QuarkusVersion).To(Equal("3.8.4"))
+			Expect(pluginConfig.OperatorSDKVersion).To(Equal("6.6.4"))
+			Expect(pluginConfig.JavaVersion).To(Equal("17"))
+			Expect(pluginConfig.ProjectVersion()).To(Equal("1.2.0"))
+			Expect(pluginConfig.EENamespace()).To(Equal("jakarta"))
+		})
+
+		It("should reject incompatible versions", func() {
+			testConfig, _ := config.New(config.Version{Number: 3})
+			versionInitSubcommand := initSubcommand{
+				domain:         "example.com",
+				projectName:    "memcached-operator",
+				quarkusVersion: "3.8.4",
+			}
+			Expect(versionInitSubcommand.InjectConfig(testConfig)).To(MatchError(ContainSubstring("unsupported versions")))
+
+			versionInitSubcommand.quarkusVersion = ""
+			versionInitSubcommand.operatorVersion = "1.0.0-SNAPSHOT"
+			Expect(versionInitSubcommand.InjectConfig(testConfig)).To(MatchError(ContainSubstring("--operator-version")))
+		})
+
+		It("should record the build tool", func() {
+			testConfig, _ := config.New(config.Version{Number: 3})
+			gradleInitSubcommand := initSubcommand{
+				domain:      "example.com",
+				projectName: "memcached-operator",
+				buildTool:   "gradle",
+			}
+			Expect(gradleInitSubcommand.InjectConfig(testConfig)).To(Succeed())
+
+			pluginConfig, err := loadPluginConfig(testConfig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pluginConfig.IsGradle()).To(BeTrue())
+			Expect(pluginConfig.BuildDir()).To(Equal("build"))
+		})
+
+		It("should reject an unknown build tool", func() {
+			testConfig, _ := config.New(config.Version{Number: 3})
+			antInitSubcommand := initSubcommand{
+				domain:      "example.com",
+				projectName: "memcached-operator",
+				buildTool:   "ant",
+			}
+			Expect(antInitSubcommand.InjectConfig(testConfig)).NotTo(Succeed())
+		})
+
+		It("should record the language", func() {
+			testConfig, _ := config.New(config.Version{Number: 3})
+			kotlinInitSubcommand := initSubcommand{
+				domain:      "example.com",
+				projectName: "memcached-operator",
+				language:    "kotlin",
+			}
+			Expect(kotlinInitSubcommand.InjectConfig(testConfig)).To(Succeed())
+
+			pluginConfig, err := loadPluginConfig(testConfig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pluginConfig.IsKotlin()).To(BeTrue())
+
+			kotlinInitSubcommand.language = "scala"
+			Expect(kotlinInitSubcommand.InjectConfig(testConfig)).NotTo(Succeed())
+		})
+
+		It("should require a version and a kind to create an API", func() {
+			testConfig, _ := config.New(config.Version{Number: 3})
+			apiInitSubcommand := initSubcommand{
+				domain:      "example.com",
+				projectName: "memcached-operator",
+				group:       "cache",
+				kind:        "Memcached",
+			}
+
+			Expect(apiInitSubcommand.InjectConfig(testConfig)).NotTo(Succeed())
+		})
+	})
+
+	Describe("Scaffold", func() {
+		It("should scaffold the project and its first API into the given filesystem only", func() {
+			testConfig, _ := config.New(config.Version{Number: 3})
+			apiInitSubcommand := initSubcommand{
+				domain:  "example.com",
+				group:   "cache",
+				version: "v1",
+				kind:    "Memcached",
+			}
+			apiInitSubcommand.apiSubcommand.BindFlags(pflag.NewFlagSet("testFlag", -1))
+			Expect(apiInitSubcommand.InjectConfig(testConfig)).To(Succeed())
+
+			memFs := afero.NewMemMapFs()
+			fs := machinery.Filesystem{FS: afero.NewBasePathFs(memFs, "/work/memcached-operator")}
+			Expect(apiInitSubcommand.Scaffold(fs)).To(Succeed())
+
+			// The project is named after the directory it is scaffolded into
+			Expect(testConfig.GetProjectName()).To(Equal("memcached-operator"))
+			for _, path := range []string{
+				"pom.xml",
+				"Makefile",
+				"src/main/resources/application.properties",
+				"src/main/java/com/example/MemcachedReconciler.java",
+				"src/main/java/com/example/v1/Memcached.java",
+			} {
+				Expect(afero.Exists(memFs, "/work/memcached-operator/"+path)).To(BeTrue(), path)
+			}
+
+			makefile, err := afero.ReadFile(fs.FS, "Makefile")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(makefile)).To(ContainSubstring("IMAGE_TAG_BASE ?= example.com/memcached-operator\n"))
+		})
+
+		It("should toggle the bundle generation with the property of the quarkus-operator-sdk version", func() {
+			for _, versions := range []struct {
+				quarkus, operatorSDK, java, property string
+			}{
+				{"", "", "", "quarkus.operator-sdk.generate-csv=false\n"},
+				{"3.8.4", "6.6.4", "17", "quarkus.operator-sdk.bundle.enabled=false\n"},
+			} {
+				testConfig, _ := config.New(config.Version{Number: 3})
+				versionInitSubcommand := initSubcommand{
+					domain:             "example.com",
+					projectName:        "memcached-operator",
+					quarkusVersion:     versions.quarkus,
+					operatorSDKVersion: versions.operatorSDK,
+					javaVersion:        versions.java,
+				}
+				versionInitSubcommand.reporter().out = io.Discard
+				Expect(versionInitSubcommand.InjectConfig(testConfig)).To(Succeed())
+				fs := machinery.Filesystem{FS: afero.NewMemMapFs()}
+				Expect(versionInitSubcommand.Scaffold(fs)).To(Succeed())
+
+				properties, err := afero.ReadFile(fs.FS, "src/main/resources/application.properties")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(properties)).To(ContainSubstring(versions.property))
+			}
+		})
+
+		It("should overwrite the files of the project with --force only", func() {
+			testConfig, _ := config.New(config.Version{Number: 3})
+			forceInitSubcommand := initSubcommand{}
+			flags := pflag.NewFlagSet("testFlag", -1)
+			forceInitSubcommand.BindFlags(flags)
+			Expect(flags.Parse([]string{"--domain", "example.com", "--project-name", "memcached-operator"})).To(Succeed())
+			Expect(forceInitSubcommand.InjectConfig(testConfig)).To(Succeed())
+
+			fs := machinery.Filesystem{FS: afero.NewMemMapFs()}
+			Expect(afero.WriteFile(fs.FS, "pom.xml", []byte("<project/>"), 0644)).To(Succeed())
+			Expect(forceInitSubcommand.Scaffold(fs)).To(MatchError(ContainSubstring("pom.xml")))
+
+			Expect(flags.Set(forceFlag, "true")).To(Succeed())
+			Expect(forceInitSubcommand.Scaffold(fs)).To(Succeed())
+			pom, err := afero.ReadFile(fs.FS, "pom.xml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(pom)).To(ContainSubstring("<artifactId>memcached-operator</artifactId>"))
+		})
+
+		It("should write nothing with --dry-run", func() {
+			testConfig, _ := config.New(config.Version{Number: 3})
+			apiInitSubcommand := initSubcommand{}
+			flags := pflag.NewFlagSet("testFlag", -1)
+			apiInitSubcommand.BindFlags(flags)
+			Expect(flags.Parse([]string{
+				"--domain", "example.com", "--group", "cache", "--version", "v1", "--kind", "Memcached", "--" + dryRunFlag,
+			})).To(Succeed())
+			Expect(apiInitSubcommand.InjectConfig(testConfig)).To(Succeed())
+
+			memFs := afero.NewMemMapFs()
+			fs := machinery.Filesystem{FS: afero.NewBasePathFs(memFs, "/work/memcached-operator")}
+			apiInitSubcommand.reporter().out = io.Discard
+			Expect(apiInitSubcommand.Scaffold(fs)).To(Succeed())
+
+			// The project is still named after its directory, in a copy of the configuration the CLI saves
+			Expect(apiInitSubcommand.config.GetProjectName()).To(Equal("memcached-operator"))
+			Expect(testConfig.GetProjectName()).To(BeEmpty())
+			Expect(testConfig.GetDomain()).To(BeEmpty())
+			resources, err := testConfig.GetResources()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resources).To(BeEmpty())
+
+			// The PROJECT file the CLI saves is removed again
+			Expect(afero.WriteFile(fs.FS, "PROJECT", []byte("version: \"3\"\n"), 0644)).To(Succeed())
+			Expect(apiInitSubcommand.PostScaffold()).To(Succeed())
+			files := 0
+			Expect(afero.Walk(memFs, "/", func(_ string, info os.FileInfo, err error) error {
+				if err == nil && !info.IsDir() {
+					files++
+				}
+				return err
+			})).To(Succeed())
+			Expect(files).To(BeZero())
+		})
 	})
 
 	Describe("Validate", func() {
-		It("should return nil", func() {
-			Expect(successInitSubcommand.Validate()).To(BeNil())
+		var fs machinery.Filesystem
+
+		BeforeEach(func() {
+			fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		})
+
+		It("should accept an empty directory", func() {
+			Expect(successInitSubcommand.Validate(fs)).To(Succeed())
+			Expect(fs.FS.Mkdir(".git", 0755)).To(Succeed())
+			Expect(successInitSubcommand.Validate(fs)).To(Succeed())
+		})
+
+		It("should refuse a directory holding a Java project", func() {
+			Expect(afero.WriteFile(fs.FS, "pom.xml", []byte("<project/>"), 0644)).To(Succeed())
+			Expect(fs.FS.MkdirAll("src/main/java", 0755)).To(Succeed())
+			Expect(successInitSubcommand.Validate(fs)).To(MatchError(
+				"the directory already holds a Java project (found pom.xml, src/main/java), " +
+					"set --force to overwrite the files scaffolded by init"))
+
+			Expect(fs.FS.RemoveAll("src")).To(Succeed())
+			Expect(fs.FS.Remove("pom.xml")).To(Succeed())
+			Expect(afero.WriteFile(fs.FS, "build.gradle", nil, 0644)).To(Succeed())
+			Expect(successInitSubcommand.Validate(fs)).To(MatchError(ContainSubstring("(found build.gradle)")))
+		})
+
+		It("should refuse a directory which is not empty", func() {
+			Expect(afero.WriteFile(fs.FS, "README.md", nil, 0644)).To(Succeed())
+			Expect(afero.WriteFile(fs.FS, "Makefile", nil, 0644)).To(Succeed())
+			Expect(successInitSubcommand.Validate(fs)).To(MatchError(
+				"the directory is not empty (found Makefile, README.md), " +
+					"set --force to initialize the project anyway, overwriting the files scaffolded by init"))
+		})
+
+		It("should accept any directory with --force", func() {
+			Expect(afero.WriteFile(fs.FS, "pom.xml", []byte("<project/>"), 0644)).To(Succeed())
+			successInitSubcommand.force = true
+			Expect(successInitSubcommand.Validate(fs)).To(Succeed())
 		})
 	})
 
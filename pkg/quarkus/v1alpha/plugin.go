@@ -29,19 +29,15 @@ var (
 )
 
 var (
-	// TODO: if we use all the full interface this could be changed to
-	// _ plugin.Full = Plugin{}
-	_ plugin.Plugin    = Plugin{}
-	_ plugin.Init      = Plugin{}
-	_ plugin.CreateAPI = Plugin{}
+	_ plugin.Full = Plugin{}
 )
 
 // Plugin implements the plugin.Full interface
 type Plugin struct {
 	initSubcommand
 	createAPISubcommand
-	// createWebhookSubcommand
-	// editSubcommand
+	createWebhookSubcommand
+	editSubcommand
 }
 
 // Name returns the name of the plugin
@@ -59,10 +55,10 @@ func (p Plugin) GetInitSubcommand() plugin.InitSubcommand { return &p.initSubcom
 // GetCreateAPISubcommand will return the subcommand which is responsible for scaffolding apis
 func (p Plugin) GetCreateAPISubcommand() plugin.CreateAPISubcommand { return &p.createAPISubcommand }
 
-// // GetCreateWebhookSubcommand will return the subcommand which is responsible for scaffolding webhooks
-// func (p Plugin) GetCreateWebhookSubcommand() plugin.CreateWebhookSubcommand {
-//     return &p.createWebhookSubcommand
-// }
+// GetCreateWebhookSubcommand will return the subcommand which is responsible for scaffolding webhooks
+func (p Plugin) GetCreateWebhookSubcommand() plugin.CreateWebhookSubcommand {
+	return &p.createWebhookSubcommand
+}
 
-// // GetEditSubcommand will return the subcommand which is responsible for editing the scaffold of the project
-// func (p Plugin) GetEditSubcommand() plugin.EditSubcommand { return &p.editSubcommand }
+// GetEditSubcommand will return the subcommand which is responsible for editing the scaffold of the project
+func (p Plugin) GetEditSubcommand() plugin.EditSubcommand { return &p.editSubcommand }
@@ -55,4 +55,16 @@ var _ = Describe("v1", func() {
 			Expect(testPlugin.GetCreateAPISubcommand(), &testPlugin.createAPISubcommand)
 		})
 	})
+
+	Describe("GetCreateWebhookSubcommand", func() {
+		It("should return the plugin createWebhookSubcommand", func() {
+			Expect(testPlugin.GetCreateWebhookSubcommand(), &testPlugin.createWebhookSubcommand)
+		})
+	})
+
+	Describe("GetEditSubcommand", func() {
+		It("should return the plugin editSubcommand", func() {
+			Expect(testPlugin.GetEditSubcommand(), &testPlugin.editSubcommand)
+		})
+	})
 })
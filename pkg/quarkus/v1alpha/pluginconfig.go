@@ -0,0 +1,48 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"errors"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/scaffolds"
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/util"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	"sigs.k8s.io/kubebuilder/v3/pkg/plugin"
+)
+
+// pluginKey is the key under which the plugin settings are stored in the PROJECT file
+var pluginKey = plugin.KeyFor(Plugin{})
+
+// loadPluginConfig returns the plugin settings stored in the project configuration. Settings which
+// are not stored, e.g. for projects scaffolded before they were tracked, are derived from the project.
+func loadPluginConfig(c config.Config) (scaffolds.PluginConfig, error) {
+	cfg := scaffolds.PluginConfig{}
+	if err := c.DecodePluginConfig(pluginKey, &cfg); err != nil && !errors.As(err, &config.PluginKeyNotFoundError{}) {
+		return cfg, err
+	}
+
+	if cfg.Package == "" {
+		cfg.Package = util.ReverseDomain(util.SanitizeDomain(c.GetDomain()))
+	}
+
+	return cfg, nil
+}
+
+// savePluginConfig stores the plugin settings in the project configuration
+func savePluginConfig(c config.Config, cfg scaffolds.PluginConfig) error {
+	return c.EncodePluginConfig(pluginKey, cfg)
+}
@@ -0,0 +1,345 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	iofs "io/fs"
+	"os"
+
+	"github.com/spf13/afero"
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/scaffolds"
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/util"
+)
+
+const (
+	outputFlag = "output"
+	outputText = "text"
+	outputJSON = "json"
+)
+
+// Actions of the changes made to the files of the project
+const (
+	actionCreate    = "create"
+	actionOverwrite = "overwrite"
+	actionModify    = "modify"
+)
+
+// Kinds of the diagnostics
+const (
+	// diagnosticSanitization tells that a name was changed into a legal Java name
+	diagnosticSanitization = "sanitization"
+	// diagnosticVersion tells that the versions the project is built with are not known to work together
+	diagnosticVersion = "version"
+	// diagnosticScaffold tells about an edit of a file which was skipped or adapted
+	diagnosticScaffold = "scaffold"
+	// diagnosticOverwrite tells that an existing file was overwritten
+	diagnosticOverwrite = "overwrite"
+	// diagnosticMigration tells that sources have to be migrated manually, after an upgrade or a change of package
+	diagnosticMigration = "migration"
+)
+
+// diagnostic is a warning raised while scaffolding the project
+type diagnostic struct {
+	Kind    string `json:"kind"`
+	Path    string `json:"path,omitempty"`
+	Message string `json:"message"`
+}
+
+// String returns the diagnostic as printed in text mode
+func (d diagnostic) String() string {
+	if d.Path == "" {
+		return d.Message
+	}
+	return d.Path + ": " + d.Message
+}
+
+// nextStep is a command to run once the subcommand succeeded
+type nextStep struct {
+	Description string `json:"description"`
+	Command     string `json:"command,omitempty"`
+}
+
+// summary is the report of a subcommand, as printed with --output=json
+type summary struct {
+	Command     string       `json:"command"`
+	DryRun      bool         `json:"dryRun,omitempty"`
+	Created     []string     `json:"created"`
+	Modified    []string     `json:"modified"`
+	Overwritten []string     `json:"overwritten"`
+	Warnings    []diagnostic `json:"warnings"`
+	NextSteps   []nextStep   `json:"nextSteps"`
+}
+
+// reporter collects the changes and the diagnostics of a subcommand and reports them in the format of --output
+type reporter struct {
+	// dryRun prints the changes to the project instead of writing them
+	dryRun bool
+	// output is the format of the report, either text or json
+	output string
+
+	// out is where the report is printed, os.Stdout by default
+	out io.Writer
+
+	summary summary
+	// edited are the existing files the scaffolders updated in place, which are reported as modified rather than
+	// overwritten
+	edited map[string]bool
+
+	// changes are the changes of the scaffolding, reported as a list followed by diffs in dry-run mode
+	changes []fileChange
+	// project is the PROJECT file as it was before the dry run, which is restored once the CLI saved it
+	project *projectSnapshot
+	// projectConfig is the copy of the project configuration the subcommand changes in dry-run mode
+	projectConfig config.Config
+}
+
+// writer returns where the report is printed
+func (r *reporter) writer() io.Writer {
+	if r.out == nil {
+		return os.Stdout
+	}
+	return r.out
+}
+
+func (r *reporter) bindFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&r.dryRun, dryRunFlag, false,
+		"print the files that would be created, overwritten or modified, with the diffs of the edited files, "+
+			"without changing the project; the CLI still rewrites the PROJECT file, which is restored afterwards")
+	fs.StringVar(&r.output, outputFlag, outputText,
+		fmt.Sprintf("format of the report of the command, either %q or %q, the latter printing a JSON summary "+
+			"of the changed files, the warnings and the next steps only", outputText, outputJSON))
+}
+
+// validate checks the --output format
+func (r *reporter) validate() error {
+	switch r.output {
+	case "":
+		r.output = outputText
+	case outputText, outputJSON:
+	default:
+		return fmt.Errorf("invalid --%s %q, expected %q or %q", outputFlag, r.output, outputText, outputJSON)
+	}
+	return nil
+}
+
+// isolate returns the project configuration the subcommand changes, which is a copy of c in dry-run mode, so that
+// the PROJECT file the CLI saves once the subcommand succeeded is left unchanged
+func (r *reporter) isolate(c config.Config) (config.Config, error) {
+	if !r.dryRun || c == r.projectConfig {
+		return c, nil
+	}
+
+	contents, err := c.MarshalYAML()
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal the project configuration: %w", err)
+	}
+	projectConfig, err := config.New(c.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	if err := projectConfig.UnmarshalYAML(contents); err != nil {
+		return nil, fmt.Errorf("unable to copy the project configuration: %w", err)
+	}
+	r.projectConfig = projectConfig
+	return projectConfig, nil
+}
+
+// warn records a warning, which is printed right away in text mode
+func (r *reporter) warn(kind, path, message string) {
+	warning := diagnostic{Kind: kind, Path: path, Message: message}
+	r.summary.Warnings = append(r.summary.Warnings, warning)
+	if r.output != outputJSON {
+		fmt.Fprintf(r.writer(), "Warning: %s\n", warning)
+	}
+}
+
+// inform prints a message about the outcome of the subcommand, in text mode and outside of dry runs only
+func (r *reporter) inform(format string, args ...interface{}) {
+	if r.output != outputJSON && !r.dryRun {
+		fmt.Fprintf(r.writer(), format+"\n", args...)
+	}
+}
+
+// warnNames records the warnings telling which names were changed into legal Java names
+func (r *reporter) warnNames(warnings ...util.Warning) {
+	for _, warning := range warnings {
+		r.warn(diagnosticSanitization, "", warning.String())
+	}
+}
+
+// warnScaffold records the diagnostics of a scaffolder
+func (r *reporter) warnScaffold(diagnostics []scaffolds.Diagnostic) {
+	for _, d := range diagnostics {
+		r.warn(diagnosticScaffold, d.Path, d.Message)
+	}
+}
+
+// edit records the existing files a scaffolder updated in place
+func (r *reporter) edit(paths ...string) {
+	if r.edited == nil {
+		r.edited = map[string]bool{}
+	}
+	for _, path := range paths {
+		r.edited[path] = true
+	}
+}
+
+// scaffold runs scaffold against an in-memory overlay of fs and records the changes it made, which are then
+// written to fs, or only reported in dry-run mode
+func (r *reporter) scaffold(command string, fs machinery.Filesystem, cfg config.Config,
+	scaffold func(machinery.Filesystem) error) error {
+	r.summary.Command = command
+	r.edited = map[string]bool{}
+	layer, changes, err := scaffoldOverlay(fs, cfg, scaffold, r.edited)
+	if err != nil {
+		return err
+	}
+
+	for _, change := range changes {
+		switch change.Action {
+		case actionCreate:
+			r.summary.Created = append(r.summary.Created, change.Path)
+		case actionModify:
+			r.summary.Modified = append(r.summary.Modified, change.Path)
+		case actionOverwrite:
+			r.summary.Overwritten = append(r.summary.Overwritten, change.Path)
+			r.warn(diagnosticOverwrite, change.Path, "the existing file is overwritten")
+		}
+	}
+
+	if !r.dryRun {
+		return commitLayer(layer, fs)
+	}
+
+	r.summary.DryRun = true
+	r.changes = changes
+	r.project, err = snapshotProject(fs)
+	return err
+}
+
+// report prints the summary of the subcommand followed by the next steps, or the changes it would make in
+// dry-run mode
+func (r *reporter) report(nextSteps ...nextStep) error {
+	if r.dryRun {
+		return r.reportDryRun()
+	}
+
+	r.summary.NextSteps = nextSteps
+	if r.output == outputJSON {
+		return r.printJSON()
+	}
+
+	for _, files := range []struct {
+		title string
+		paths []string
+	}{
+		{"Created", r.summary.Created},
+		{"Modified", r.summary.Modified},
+		{"Overwritten", r.summary.Overwritten},
+	} {
+		if len(files.paths) == 0 {
+			continue
+		}
+		fmt.Fprintf(r.writer(), "%s:\n", files.title)
+		for _, path := range files.paths {
+			fmt.Fprintf(r.writer(), "  %s\n", path)
+		}
+	}
+	for _, step := range nextSteps {
+		if step.Command == "" {
+			fmt.Fprintf(r.writer(), "Next: %s\n", step.Description)
+			continue
+		}
+		fmt.Fprintf(r.writer(), "Next: %s with:\n$ %s\n", step.Description, step.Command)
+	}
+	return nil
+}
+
+// reportDryRun restores the PROJECT file the CLI saved and prints the changes the subcommand would make
+func (r *reporter) reportDryRun() error {
+	if r.project != nil {
+		if err := r.project.restore(); err != nil {
+			return err
+		}
+	}
+
+	if r.output == outputJSON {
+		return r.printJSON()
+	}
+	report, err := dryRunReport(r.changes)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(r.writer(), report)
+	return nil
+}
+
+// printJSON prints the summary as JSON, the empty lists included
+func (r *reporter) printJSON() error {
+	s := r.summary
+	if s.Created == nil {
+		s.Created = []string{}
+	}
+	if s.Modified == nil {
+		s.Modified = []string{}
+	}
+	if s.Overwritten == nil {
+		s.Overwritten = []string{}
+	}
+	if s.Warnings == nil {
+		s.Warnings = []diagnostic{}
+	}
+	if s.NextSteps == nil {
+		s.NextSteps = []nextStep{}
+	}
+
+	out, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("unable to marshal the summary: %w", err)
+	}
+	fmt.Fprintln(r.writer(), string(out))
+	return nil
+}
+
+// commitLayer writes the directories and the files of the layer to fs
+func commitLayer(layer afero.Fs, fs machinery.Filesystem) error {
+	return afero.Walk(layer, ".", func(path string, info iofs.FileInfo, err error) error {
+		switch {
+		case err != nil:
+			return err
+		case path == ".":
+			return nil
+		case info.IsDir():
+			return fs.FS.MkdirAll(path, info.Mode().Perm())
+		}
+
+		content, err := afero.ReadFile(layer, path)
+		if err != nil {
+			return err
+		}
+		if err := afero.WriteFile(fs.FS, path, content, info.Mode().Perm()); err != nil {
+			return fmt.Errorf("error writing %s: %w", path, err)
+		}
+		return nil
+	})
+}
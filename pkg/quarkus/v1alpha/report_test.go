@@ -0,0 +1,308 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v3/pkg/model/resource"
+)
+
+var _ = Describe("reporter", func() {
+	var (
+		memFs      afero.Fs
+		fs         machinery.Filesystem
+		testConfig config.Config
+		out        *bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		memFs = afero.NewMemMapFs()
+		fs = machinery.Filesystem{FS: memFs}
+		testConfig, _ = config.New(config.Version{Number: 3})
+		out = new(bytes.Buffer)
+	})
+
+	// initProject runs init with the flags, printing the report to out
+	initProject := func(args ...string) error {
+		initSubcommand := initSubcommand{commandName: "operator-sdk"}
+		flags := pflag.NewFlagSet("testFlag", -1)
+		initSubcommand.BindFlags(flags)
+		Expect(flags.Parse(append([]string{"--domain", "example.com", "--project-name", "memcached-operator"},
+			args...))).To(Succeed())
+		initSubcommand.reporter().out = out
+
+		if err := initSubcommand.InjectConfig(testConfig); err != nil {
+			return err
+		}
+		if err := initSubcommand.PreScaffold(fs); err != nil {
+			return err
+		}
+		if err := initSubcommand.Scaffold(fs); err != nil {
+			return err
+		}
+		return initSubcommand.PostScaffold()
+	}
+
+	parseSummary := func() summary {
+		var s summary
+		Expect(json.Unmarshal(out.Bytes(), &s)).To(Succeed())
+		return s
+	}
+
+	It("prints the summary of init as JSON with --output=json", func() {
+		Expect(initProject("--group", "cache", "--version", "v1", "--kind", "Override",
+			"--"+outputFlag, outputJSON)).To(Succeed())
+
+		s := parseSummary()
+		Expect(s.Command).To(Equal("init"))
+		Expect(s.DryRun).To(BeFalse())
+		Expect(s.Created).To(ContainElements("PROJECT", "pom.xml", "Makefile",
+			"src/main/java/com/example/v1/OverrideResource.java"))
+		Expect(s.Modified).To(BeEmpty())
+		Expect(s.Warnings).To(ContainElements(diagnostic{
+			Kind:    diagnosticSanitization,
+			Message: `"Override" clashes with java.lang.Override, using "OverrideResource" instead`,
+		}))
+		Expect(s.NextSteps).To(Equal([]nextStep{{
+			Description: "implement the Override reconciler and build the operator image",
+			Command:     "make docker-build",
+		}}))
+
+		// The files are written all the same
+		Expect(afero.Exists(memFs, "src/main/java/com/example/v1/OverrideResource.java")).To(BeTrue())
+	})
+
+	It("reports the files overwritten by init with --force", func() {
+		Expect(afero.WriteFile(memFs, ".gitignore", []byte("*.class\n"), 0644)).To(Succeed())
+		Expect(initProject("--"+forceFlag, "--"+outputFlag, outputJSON)).To(Succeed())
+
+		s := parseSummary()
+		Expect(s.Overwritten).To(Equal([]string{".gitignore"}))
+		Expect(s.Created).NotTo(ContainElement(".gitignore"))
+		Expect(s.Warnings).To(ContainElements(diagnostic{
+			Kind:    diagnosticOverwrite,
+			Path:    ".gitignore",
+			Message: "the existing file is overwritten",
+		}))
+		Expect(s.NextSteps).To(Equal([]nextStep{{Description: "define a resource", Command: "operator-sdk create api"}}))
+	})
+
+	It("prints the summary of create api as text", func() {
+		Expect(initProject("--"+outputFlag, outputJSON)).To(Succeed())
+		out.Reset()
+
+		apiSubcommand := createAPISubcommand{}
+		flags := pflag.NewFlagSet("testFlag", -1)
+		apiSubcommand.BindFlags(flags)
+		apiSubcommand.report.out = out
+		Expect(apiSubcommand.InjectConfig(testConfig)).To(Succeed())
+		res := resource.Resource{
+			GVK:    resource.GVK{Group: "cache", Domain: "example.com", Version: "v1", Kind: "Memcached"},
+			Plural: "memcacheds",
+		}
+		Expect(apiSubcommand.InjectResource(&res)).To(Succeed())
+		Expect(apiSubcommand.Scaffold(fs)).To(Succeed())
+		Expect(apiSubcommand.PostScaffold()).To(Succeed())
+
+		Expect(out.String()).To(ContainSubstring("Created:\n  PROJECT\n  src/main/java/com/example/MemcachedReconciler.java\n"))
+		Expect(out.String()).To(ContainSubstring("Modified:\n  Makefile\n"))
+		Expect(out.String()).To(HaveSuffix(
+			"Next: implement the Memcached reconciler and build the operator image with:\n$ make docker-build\n"))
+	})
+
+	It("collects the diagnostics of the scaffolders", func() {
+		Expect(initProject()).To(Succeed())
+		Expect(afero.WriteFile(memFs, "Makefile", []byte("all: docker-build\n"), 0644)).To(Succeed())
+		out.Reset()
+
+		apiSubcommand := createAPISubcommand{options: createAPIOptions{CRDVersion: "v1"},
+			report: reporter{output: outputJSON, out: out}}
+		Expect(apiSubcommand.InjectConfig(testConfig)).To(Succeed())
+		res := resource.Resource{
+			GVK:    resource.GVK{Group: "cache", Domain: "example.com", Version: "v1", Kind: "Memcached"},
+			Plural: "memcacheds",
+		}
+		Expect(apiSubcommand.InjectResource(&res)).To(Succeed())
+		Expect(apiSubcommand.Scaffold(fs)).To(Succeed())
+		Expect(apiSubcommand.PostScaffold()).To(Succeed())
+
+		s := parseSummary()
+		Expect(s.Command).To(Equal("create api"))
+		Expect(s.Modified).To(Equal([]string{"Makefile"}))
+		Expect(s.Warnings).To(ContainElements(diagnostic{
+			Kind:    diagnosticScaffold,
+			Path:    "Makefile",
+			Message: "added the bundle targets, which the Makefile lacked",
+		}))
+	})
+
+	It("prints the dry-run summary as JSON", func() {
+		Expect(initProject("--"+dryRunFlag, "--"+outputFlag, outputJSON)).To(Succeed())
+
+		s := parseSummary()
+		Expect(s.DryRun).To(BeTrue())
+		Expect(s.Created).To(ContainElements("PROJECT", "pom.xml"))
+		Expect(afero.Exists(memFs, "pom.xml")).To(BeFalse())
+	})
+
+	It("reports the models of the versions no longer stored as modified", func() {
+		Expect(initProject("--group", "cache", "--version", "v1", "--kind", "Memcached",
+			"--"+outputFlag, outputJSON)).To(Succeed())
+		out.Reset()
+
+		apiSubcommand := createAPISubcommand{}
+		flags := pflag.NewFlagSet("testFlag", -1)
+		apiSubcommand.BindFlags(flags)
+		Expect(flags.Parse([]string{"--" + storageVersionFlag, "--" + dryRunFlag,
+			"--" + outputFlag, outputJSON})).To(Succeed())
+		apiSubcommand.report.out = out
+		Expect(apiSubcommand.InjectConfig(testConfig)).To(Succeed())
+		res := resource.Resource{
+			GVK:    resource.GVK{Group: "cache", Domain: "example.com", Version: "v2", Kind: "Memcached"},
+			Plural: "memcacheds",
+		}
+		Expect(apiSubcommand.InjectResource(&res)).To(Succeed())
+		Expect(apiSubcommand.Scaffold(fs)).To(Succeed())
+		Expect(apiSubcommand.PostScaffold()).To(Succeed())
+
+		s := parseSummary()
+		Expect(s.DryRun).To(BeTrue())
+		Expect(s.Modified).To(ContainElement("src/main/java/com/example/v1/Memcached.java"))
+		Expect(s.Overwritten).To(BeEmpty())
+	})
+
+	It("reports the warnings and the files to migrate of edit", func() {
+		Expect(initProject("--"+outputFlag, outputJSON)).To(Succeed())
+		reconcilers := "src/main/java/com/example/Reconcilers.java"
+		Expect(afero.WriteFile(memFs, reconcilers, []byte(`package com.example;
+
+import io.javaoperatorsdk.operator.api.reconciler.Context;
+import io.javaoperatorsdk.operator.api.reconciler.Reconciler;
+
+public class Reconcilers {
+  public static class MemcachedReconciler implements Reconciler<Memcached> {
+    public UpdateControl<Memcached> reconcile(Memcached resource, Context context) {
+      return UpdateControl.noUpdate();
+    }
+  }
+
+  public static class RedisReconciler implements Reconciler<Redis> {
+    public UpdateControl<Redis> reconcile(Redis resource, Context context) {
+      return UpdateControl.noUpdate();
+    }
+  }
+}
+`), 0644)).To(Succeed())
+		out.Reset()
+
+		editSubcommand := editSubcommand{}
+		flags := pflag.NewFlagSet("testFlag", -1)
+		editSubcommand.BindFlags(flags)
+		Expect(flags.Parse([]string{"--" + upgradeFlag, "--" + packageFlag, "com.acme",
+			"--" + outputFlag, outputJSON})).To(Succeed())
+		editSubcommand.report.out = out
+		Expect(editSubcommand.InjectConfig(testConfig)).To(Succeed())
+		Expect(editSubcommand.Scaffold(fs)).To(Succeed())
+		Expect(editSubcommand.PostScaffold()).To(Succeed())
+
+		s := parseSummary()
+		Expect(s.Command).To(Equal("edit"))
+		Expect(s.Modified).To(ContainElement("pom.xml"))
+		Expect(s.Warnings).To(ContainElements(
+			diagnostic{
+				Kind: diagnosticMigration,
+				Path: reconcilers,
+				Message: "uses Context without type arguments, which have to be added by hand as the custom " +
+					"resource is not known",
+			},
+			diagnostic{
+				Kind: diagnosticMigration,
+				Message: "the sources of new APIs will be generated into package com.acme, existing sources " +
+					"have to be moved manually",
+			},
+		))
+	})
+
+	It("prints the next step of create webhook without a command", func() {
+		Expect(initProject("--group", "cache", "--version", "v1", "--kind", "Memcached",
+			"--"+outputFlag, outputJSON)).To(Succeed())
+		out.Reset()
+
+		webhookSubcommand := createWebhookSubcommand{}
+		flags := pflag.NewFlagSet("testFlag", -1)
+		webhookSubcommand.BindFlags(flags)
+		Expect(flags.Parse([]string{"--defaulting"})).To(Succeed())
+		webhookSubcommand.report.out = out
+		Expect(webhookSubcommand.InjectConfig(testConfig)).To(Succeed())
+		res := resource.Resource{
+			GVK:    resource.GVK{Group: "cache", Domain: "example.com", Version: "v1", Kind: "Memcached"},
+			Plural: "memcacheds",
+		}
+		Expect(webhookSubcommand.InjectResource(&res)).To(Succeed())
+		Expect(webhookSubcommand.Scaffold(fs)).To(Succeed())
+		Expect(webhookSubcommand.PostScaffold()).To(Succeed())
+
+		Expect(out.String()).To(ContainSubstring("  src/main/java/com/example/v1/MemcachedDefaulter.java\n"))
+		Expect(out.String()).To(HaveSuffix("Next: implement your new webhook and register it in your cluster with a " +
+			"MutatingWebhookConfiguration, ValidatingWebhookConfiguration or CRD conversion strategy\n"))
+	})
+
+	It("reports the conversion endpoint extended by another version as modified", func() {
+		Expect(initProject("--group", "cache", "--version", "v1", "--kind", "Memcached",
+			"--"+outputFlag, outputJSON)).To(Succeed())
+		v2 := resource.Resource{
+			GVK:    resource.GVK{Group: "cache", Domain: "example.com", Version: "v2", Kind: "Memcached"},
+			Plural: "memcacheds",
+			API:    &resource.API{CRDVersion: "v1", Namespaced: true},
+		}
+		Expect(testConfig.AddResource(v2)).To(Succeed())
+
+		for _, version := range []string{"v1", "v2"} {
+			out.Reset()
+			webhookSubcommand := createWebhookSubcommand{}
+			flags := pflag.NewFlagSet("testFlag", -1)
+			webhookSubcommand.BindFlags(flags)
+			Expect(flags.Parse([]string{"--conversion", "--" + outputFlag, outputJSON})).To(Succeed())
+			webhookSubcommand.report.out = out
+			Expect(webhookSubcommand.InjectConfig(testConfig)).To(Succeed())
+			res := resource.Resource{
+				GVK:    resource.GVK{Group: "cache", Domain: "example.com", Version: version, Kind: "Memcached"},
+				Plural: "memcacheds",
+			}
+			Expect(webhookSubcommand.InjectResource(&res)).To(Succeed())
+			Expect(webhookSubcommand.Scaffold(fs)).To(Succeed())
+			Expect(webhookSubcommand.PostScaffold()).To(Succeed())
+		}
+
+		s := parseSummary()
+		Expect(s.Created).To(ContainElement("src/main/java/com/example/v2/MemcachedMapper.java"))
+		Expect(s.Modified).To(ContainElement("src/main/java/com/example/MemcachedConversionEndpoint.java"))
+		Expect(s.Overwritten).To(BeEmpty())
+		Expect(s.Warnings).To(BeEmpty())
+	})
+
+	It("fails on unknown output formats", func() {
+		Expect(initProject("--"+outputFlag, "yaml")).To(MatchError(`invalid --output "yaml", expected "text" or "json"`))
+	})
+})
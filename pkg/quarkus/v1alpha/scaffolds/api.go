@@ -15,36 +15,64 @@
 package scaffolds
 
 import (
+	"errors"
+	"fmt"
+	iofs "io/fs"
+	"strings"
+
+	"github.com/spf13/afero"
 	"sigs.k8s.io/kubebuilder/v3/pkg/config"
 	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v3/pkg/model/resource"
 	"sigs.k8s.io/kubebuilder/v3/pkg/plugins"
 
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/templates/model"
+	templatesutil "github.com/operator-framework/java-operator-plugins/pkg/internal/templates/util"
 	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/scaffolds/internal/templates/controller"
-	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/scaffolds/internal/templates/model"
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/scaffolds/internal/templates/webhook"
 	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/util"
 )
 
-type apiScaffolder struct {
+// Diagnostic is a notable event of the scaffolding of a file, such as an edit which was skipped
+type Diagnostic struct {
+	// Path is the path of the file
+	Path string
+
+	// Message describes the event
+	Message string
+}
+
+var _ plugins.Scaffolder = &CreateAPIScaffolder{}
+
+// CreateAPIScaffolder scaffolds the models and the reconciler of an API
+type CreateAPIScaffolder struct {
 	fs machinery.Filesystem
 
-	config   config.Config
-	resource resource.Resource
+	config       config.Config
+	pluginConfig PluginConfig
+	resource     resource.Resource
+	schema       model.Schema
+
+	diagnostics []Diagnostic
+	edited      []string
 }
 
-// NewCreateAPIScaffolder returns a new plugins.Scaffolder for project initialization operations
-func NewCreateAPIScaffolder(cfg config.Config, res resource.Resource) plugins.Scaffolder {
-	return &apiScaffolder{
-		config:   cfg,
-		resource: res,
+// NewCreateAPIScaffolder returns a new plugins.Scaffolder for project initialization operations.
+// The spec and status classes declare the properties of the schema, if any.
+func NewCreateAPIScaffolder(cfg config.Config, pluginConfig PluginConfig, res resource.Resource, schema model.Schema) *CreateAPIScaffolder {
+	return &CreateAPIScaffolder{
+		config:       cfg,
+		pluginConfig: pluginConfig,
+		resource:     res,
+		schema:       schema,
 	}
 }
 
-func (s *apiScaffolder) InjectFS(fs machinery.Filesystem) {
+func (s *CreateAPIScaffolder) InjectFS(fs machinery.Filesystem) {
 	s.fs = fs
 }
 
-func (s *apiScaffolder) Scaffold() error {
+func (s *CreateAPIScaffolder) Scaffold() error {
 
 	if err := s.config.UpdateResource(s.resource); err != nil {
 		return err
@@ -59,25 +87,200 @@ func (s *apiScaffolder) Scaffold() error {
 		machinery.WithResource(&s.resource),
 	)
 
+	multiGroup := s.config.IsMultiGroup()
+	modelPackage := s.pluginConfig.ModelPackage(s.resource, multiGroup)
+	// The first version of a kind is stored unless another version is known to be
+	storageVersion := s.pluginConfig.StorageVersion(s.resource)
+	storage := storageVersion == "" || storageVersion == s.resource.Version
+	crdNames := s.pluginConfig.CRDNames(s.resource)
+	apiOptions := s.pluginConfig.APIOptions(s.resource)
+	schema, err := apiOptions.Apply(s.schema)
+	if err != nil {
+		return err
+	}
+
 	var createAPITemplates []machinery.Builder
 	createAPITemplates = append(createAPITemplates,
 		&model.Model{
-			Package:   util.ReverseDomain(util.SanitizeDomain(s.config.GetDomain())),
-			ClassName: util.ToClassname(s.resource.Kind),
+			Package:   modelPackage,
+			ClassName: ModelClassName(s.resource.Kind),
+			Kotlin:    s.pluginConfig.IsKotlin(),
+			Storage:   storage,
+			// The bundle looks the CRD manifest up by the plural of the resource
+			DeclarePlural: true,
+			ShortNames:    crdNames.ShortNames,
+			Categories:    crdNames.Categories,
+			NoStatus:      apiOptions.NoStatus,
 		},
 		&model.ModelSpec{
-			Package:   util.ReverseDomain(util.SanitizeDomain(s.config.GetDomain())),
-			ClassName: util.ToClassname(s.resource.Kind),
-		},
-		&model.ModelStatus{
-			Package:   util.ReverseDomain(util.SanitizeDomain(s.config.GetDomain())),
-			ClassName: util.ToClassname(s.resource.Kind),
+			Package:    modelPackage,
+			ClassName:  ModelClassName(s.resource.Kind),
+			Kotlin:     s.pluginConfig.IsKotlin(),
+			Properties: schema.Spec,
 		},
+	)
+	if !apiOptions.NoStatus {
+		createAPITemplates = append(createAPITemplates, &model.ModelStatus{
+			Package:    modelPackage,
+			ClassName:  ModelClassName(s.resource.Kind),
+			Kotlin:     s.pluginConfig.IsKotlin(),
+			Properties: schema.Status,
+		})
+	}
+	reconcilerOptions := s.pluginConfig.ReconcilerOptions(s.resource)
+	createAPITemplates = append(createAPITemplates,
+		// The reconciler of a kind is scaffolded along with its first version only
 		&controller.Controller{
-			Package:   util.ReverseDomain(util.SanitizeDomain(s.config.GetDomain())),
-			ClassName: util.ToClassname(s.resource.Kind),
+			Package:            s.pluginConfig.ResourcePackage(s.resource, multiGroup),
+			ModelPackage:       modelPackage,
+			ClassName:          ModelClassName(s.resource.Kind),
+			Kotlin:             s.pluginConfig.IsKotlin(),
+			GenericContext:     s.pluginConfig.GenericContext(),
+			Cleanup:            reconcilerOptions.Cleanup,
+			CleanerInterface:   s.pluginConfig.CleanerInterface(),
+			ErrorStatusHandler: reconcilerOptions.ErrorStatusHandler,
+			EventSources:       reconcilerOptions.EventSources,
 		},
 	)
 
-	return scaffold.Execute(createAPITemplates...)
+	makefileUpdater, notes, err := bundleCRD(s.fs, s.config, s.pluginConfig, s.resource)
+	if err != nil {
+		return err
+	}
+	for _, note := range notes {
+		s.diagnostics = append(s.diagnostics, Diagnostic{Path: makefileFile, Message: note})
+	}
+	if makefileUpdater != nil {
+		createAPITemplates = append(createAPITemplates, makefileUpdater)
+	}
+	if storage {
+		mappers, err := s.hubMappers(modelPackage)
+		if err != nil {
+			return err
+		}
+		createAPITemplates = append(createAPITemplates, mappers...)
+	}
+
+	if err := scaffold.Execute(createAPITemplates...); err != nil {
+		return err
+	}
+
+	if storage {
+		return s.unsetStorageVersions()
+	}
+	return nil
+}
+
+// Diagnostics returns the notable events of the scaffolding
+func (s *CreateAPIScaffolder) Diagnostics() []Diagnostic {
+	return s.diagnostics
+}
+
+// Edited returns the existing sources the scaffolding updated in place, such as the models of the versions of the
+// kind which are no longer stored
+func (s *CreateAPIScaffolder) Edited() []string {
+	return s.edited
+}
+
+// ModelClassName returns the name of the model class of a kind, which is suffixed if the kind clashes with the
+// classes the generated sources use
+func ModelClassName(kind string) string {
+	className, _ := util.KindClassName(kind)
+	return className
+}
+
+// ModelClassNames returns the names of the classes generated in the model package of a kind
+func ModelClassNames(kind string) []string {
+	className := ModelClassName(kind)
+	names := []string{className}
+	for _, suffix := range []string{"Spec", "Status", "Defaulter", "Validator", "Mapper", "WebhookEndpoint"} {
+		names = append(names, className+suffix)
+	}
+	return names
+}
+
+// unsetStorageVersions updates the models of the other versions of the resource kind, which are no longer stored
+func (s *CreateAPIScaffolder) unsetStorageVersions() error {
+	versions, err := kindVersions(s.config, s.resource)
+	if err != nil {
+		return err
+	}
+
+	for _, res := range versions {
+		if res.Version == s.resource.Version {
+			continue
+		}
+
+		storage, served := model.VersionAnnotations(res.Version)
+		// Projects scaffolded before the models were split by version hold them in the base package
+		for _, pkg := range []string{s.pluginConfig.ModelPackage(res, s.config.IsMultiGroup()), s.pluginConfig.Package} {
+			path := s.modelPath(pkg, res.Kind)
+			exists, err := afero.Exists(s.fs.FS, path)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				continue
+			}
+
+			if err := updateFile(s.fs, path, func(contents string) (string, error) {
+				return strings.Replace(contents, storage, served, 1), nil
+			}); err != nil {
+				return err
+			}
+			s.edited = append(s.edited, path)
+		}
+	}
+	return nil
+}
+
+// hubMappers returns the mappers of the other versions of the resource kind which still convert to and from
+// themselves, i.e. the stubs scaffolded while their version was stored, regenerated to convert to and from the new
+// storage version whose models are in hubPackage
+func (s *CreateAPIScaffolder) hubMappers(hubPackage string) ([]machinery.Builder, error) {
+	versions, err := kindVersions(s.config, s.resource)
+	if err != nil {
+		return nil, err
+	}
+
+	className := ModelClassName(s.resource.Kind)
+	var mappers []machinery.Builder
+	for i, res := range versions {
+		if res.Version == s.resource.Version || !res.HasConversionWebhook() {
+			continue
+		}
+
+		pkg := s.pluginConfig.ModelPackage(res, s.config.IsMultiGroup())
+		path := templatesutil.PrependJavaPath(className+"Mapper.java", templatesutil.AsPath(pkg))
+		contents, err := afero.ReadFile(s.fs.FS, path)
+		if err != nil {
+			if errors.Is(err, iofs.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+		if !strings.Contains(string(contents), fmt.Sprintf("implements Mapper<%[1]s, %[1]s>", className)) {
+			continue
+		}
+
+		mappers = append(mappers, &webhook.Conversion{
+			ResourceMixin: machinery.ResourceMixin{Resource: &versions[i]},
+			Package:       pkg,
+			HubPackage:    hubPackage,
+			ClassName:     className,
+			Force:         true,
+		})
+		s.edited = append(s.edited, path)
+		s.diagnostics = append(s.diagnostics, Diagnostic{Path: path, Message: fmt.Sprintf(
+			"the mapper was regenerated to convert to and from the new storage version %s", s.resource.Version)})
+	}
+	return mappers, nil
+}
+
+// modelPath returns the path of the model class of a kind in a package
+func (s *CreateAPIScaffolder) modelPath(pkg, kind string) string {
+	if s.pluginConfig.IsKotlin() {
+		return templatesutil.PrependKotlinPath(ModelClassName(kind)+".kt", templatesutil.AsPath(pkg))
+	}
+	return templatesutil.PrependJavaPath(ModelClassName(kind)+".java", templatesutil.AsPath(pkg))
 }
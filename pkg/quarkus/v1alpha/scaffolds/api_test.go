@@ -0,0 +1,329 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffolds
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	v3 "sigs.k8s.io/kubebuilder/v3/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v3/pkg/model/resource"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/templates/model"
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/scaffolds/internal/templates"
+)
+
+var _ = Describe("apiScaffolder", func() {
+	var (
+		fs  machinery.Filesystem
+		cfg config.Config
+		res resource.Resource
+	)
+
+	BeforeEach(func() {
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		cfg = v3.New()
+		Expect(cfg.SetDomain("example.com")).To(Succeed())
+		Expect(cfg.SetProjectName("memcached-operator")).To(Succeed())
+		Expect(machinery.NewScaffold(fs, machinery.WithConfig(cfg)).Execute(
+			&templates.Makefile{KustomizeVersion: kustomizeVersion, OperatorVersion: DefaultOperatorVersion},
+		)).To(Succeed())
+		res = resource.Resource{
+			GVK:    resource.GVK{Group: "cache", Domain: "example.com", Version: "v1", Kind: "Memcached"},
+			Plural: "memcacheds",
+			API:    &resource.API{CRDVersion: "v1", Namespaced: true},
+		}
+	})
+
+	scaffoldSchema := func(pluginConfig PluginConfig, schema model.Schema) {
+		scaffolder := NewCreateAPIScaffolder(cfg, pluginConfig, res, schema)
+		scaffolder.InjectFS(fs)
+		Expect(scaffolder.Scaffold()).To(Succeed())
+	}
+
+	scaffold := func(pluginConfig PluginConfig) {
+		scaffoldSchema(pluginConfig, model.Schema{})
+	}
+
+	It("generates the Java sources by default", func() {
+		scaffold(PluginConfig{Package: "com.example"})
+
+		for _, name := range []string{"Memcached", "MemcachedSpec", "MemcachedStatus"} {
+			Expect(afero.Exists(fs.FS, "src/main/java/com/example/v1/"+name+".java")).To(BeTrue())
+		}
+		reconciler, err := afero.ReadFile(fs.FS, "src/main/java/com/example/MemcachedReconciler.java")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(reconciler)).To(ContainSubstring("import com.example.v1.Memcached;"))
+
+		makefile, err := afero.ReadFile(fs.FS, "Makefile")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(makefile)).To(ContainSubstring(
+			"BUNDLE_CRDS += target/kubernetes/memcacheds.cache.example.com-v1.yml\n#+kubebuilder:scaffold:bundle-crds\n"))
+	})
+
+	It("generates the Kotlin sources when the project uses Kotlin", func() {
+		scaffold(PluginConfig{Package: "com.example", Language: LanguageKotlin})
+
+		for _, name := range []string{"Memcached", "MemcachedSpec", "MemcachedStatus"} {
+			Expect(afero.Exists(fs.FS, "src/main/kotlin/com/example/v1/"+name+".kt")).To(BeTrue())
+		}
+		Expect(afero.DirExists(fs.FS, "src/main/java")).To(BeFalse())
+
+		reconciler, err := afero.ReadFile(fs.FS, "src/main/kotlin/com/example/MemcachedReconciler.kt")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(reconciler)).To(ContainSubstring(
+			"class MemcachedReconciler(private val client: KubernetesClient) : Reconciler<Memcached> {"))
+	})
+
+	It("types the Context of the reconciler as of quarkus-operator-sdk 4", func() {
+		scaffold(PluginConfig{Package: "com.example", QuarkusVersion: LatestQuarkusVersion, OperatorSDKVersion: LatestOperatorSDKVersion})
+
+		reconciler, err := afero.ReadFile(fs.FS, "src/main/java/com/example/MemcachedReconciler.java")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(reconciler)).To(ContainSubstring("reconcile(Memcached resource, Context<Memcached> context)"))
+	})
+
+	reconcilers := map[string]ReconcilerOptions{
+		"memcacheds.cache.example.com": {Cleanup: true, ErrorStatusHandler: true, EventSources: true},
+	}
+
+	It("implements the capabilities of the reconciler", func() {
+		scaffold(PluginConfig{Package: "com.example", OperatorSDKVersion: LatestOperatorSDKVersion, Reconcilers: reconcilers})
+
+		reconciler, err := afero.ReadFile(fs.FS, "src/main/java/com/example/MemcachedReconciler.java")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(reconciler)).To(ContainSubstring("public class MemcachedReconciler implements Reconciler<Memcached>, " +
+			"Cleaner<Memcached>, ErrorStatusHandler<Memcached>, EventSourceInitializer<Memcached> {"))
+		Expect(string(reconciler)).To(ContainSubstring(
+			"public DeleteControl cleanup(Memcached resource, Context<Memcached> context) {"))
+		Expect(string(reconciler)).To(ContainSubstring("public ErrorStatusUpdateControl<Memcached> " +
+			"updateErrorStatus(Memcached resource, Context<Memcached> context, Exception e) {"))
+		Expect(string(reconciler)).To(ContainSubstring(
+			"public Map<String, EventSource> prepareEventSources(EventSourceContext<Memcached> context) {"))
+		Expect(string(reconciler)).To(ContainSubstring("import java.util.Map;"))
+	})
+
+	It("implements the capabilities of the Kotlin reconciler", func() {
+		scaffold(PluginConfig{Package: "com.example", Language: LanguageKotlin, OperatorSDKVersion: LatestOperatorSDKVersion,
+			Reconcilers: reconcilers})
+
+		reconciler, err := afero.ReadFile(fs.FS, "src/main/kotlin/com/example/MemcachedReconciler.kt")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(reconciler)).To(ContainSubstring(
+			"override fun updateErrorStatus(resource: Memcached, context: Context<Memcached>, e: Exception): " +
+				"ErrorStatusUpdateControl<Memcached> {"))
+		Expect(string(reconciler)).To(ContainSubstring(
+			"override fun prepareEventSources(context: EventSourceContext<Memcached>): Map<String, EventSource> {"))
+		Expect(string(reconciler)).NotTo(ContainSubstring("import java.util.Map"))
+	})
+
+	It("overrides the cleanup method of Reconciler before quarkus-operator-sdk 5", func() {
+		scaffold(PluginConfig{Package: "com.example", Reconcilers: map[string]ReconcilerOptions{
+			"memcacheds.cache.example.com": {Cleanup: true},
+		}})
+
+		reconciler, err := afero.ReadFile(fs.FS, "src/main/java/com/example/MemcachedReconciler.java")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(reconciler)).To(ContainSubstring("public class MemcachedReconciler implements Reconciler<Memcached> {"))
+		Expect(string(reconciler)).To(ContainSubstring("public DeleteControl cleanup(Memcached resource, Context context) {"))
+		Expect(string(reconciler)).NotTo(ContainSubstring("Cleaner"))
+	})
+
+	It("suffixes the classes of the kinds clashing with the classes of the generated sources", func() {
+		res.Kind = "Override"
+		res.Plural = "overrides"
+		scaffold(PluginConfig{Package: "com.example"})
+
+		for _, name := range []string{"OverrideResource", "OverrideResourceSpec", "OverrideResourceStatus"} {
+			Expect(afero.Exists(fs.FS, "src/main/java/com/example/v1/"+name+".java")).To(BeTrue())
+		}
+		customResource, err := afero.ReadFile(fs.FS, "src/main/java/com/example/v1/OverrideResource.java")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(customResource)).To(ContainSubstring("import io.fabric8.kubernetes.model.annotation.Kind;\n"))
+		Expect(string(customResource)).To(ContainSubstring("@Kind(\"Override\")\n@Plural(\"overrides\")\npublic class OverrideResource extends"))
+
+		reconciler, err := afero.ReadFile(fs.FS, "src/main/java/com/example/OverrideResourceReconciler.java")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(reconciler)).To(ContainSubstring("implements Reconciler<OverrideResource>"))
+	})
+
+	It("declares the plural, the short names and the categories of the CRD", func() {
+		pluginConfig := PluginConfig{Package: "com.example"}
+		pluginConfig.SetCRDNames(res, CRDNames{ShortNames: []string{"mc", "mcd"}, Categories: []string{"all"}})
+		scaffold(pluginConfig)
+
+		customResource, err := afero.ReadFile(fs.FS, "src/main/java/com/example/v1/Memcached.java")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(customResource)).To(ContainSubstring("import io.fabric8.kubernetes.model.annotation.Categories;\n"))
+		Expect(string(customResource)).To(ContainSubstring("import io.fabric8.kubernetes.model.annotation.Kind;\n"))
+		Expect(string(customResource)).To(ContainSubstring("import io.fabric8.kubernetes.model.annotation.ShortNames;\n"))
+		Expect(string(customResource)).To(ContainSubstring(
+			"@Kind(\"Memcached\")\n@Plural(\"memcacheds\")\n@ShortNames({\"mc\", \"mcd\"})\n@Categories({\"all\"})\npublic class Memcached "))
+
+		res.Version = "v2"
+		pluginConfig.Language = LanguageKotlin
+		scaffold(pluginConfig)
+		kotlinResource, err := afero.ReadFile(fs.FS, "src/main/kotlin/com/example/v2/Memcached.kt")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(kotlinResource)).To(ContainSubstring("import io.fabric8.kubernetes.model.annotation.Kind\n"))
+		Expect(string(kotlinResource)).To(ContainSubstring(
+			"@Kind(\"Memcached\")\n@Plural(\"memcacheds\")\n@ShortNames(\"mc\", \"mcd\")\n@Categories(\"all\")\nclass Memcached "))
+	})
+
+	It("annotates the printed fields and the replicas of the scale subresource", func() {
+		pluginConfig := PluginConfig{Package: "com.example"}
+		pluginConfig.SetAPIOptions(res, APIOptions{
+			PrintColumns: []PrintColumn{{Name: "Size", Type: ColumnInteger, JSONPath: ".spec.size"}},
+			Scale:        &Scale{SpecReplicasPath: ".spec.size", StatusReplicasPath: ".status.replicas"},
+		})
+		scaffold(pluginConfig)
+
+		spec, err := afero.ReadFile(fs.FS, "src/main/java/com/example/v1/MemcachedSpec.java")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(spec)).To(ContainSubstring("import io.fabric8.crd.generator.annotation.PrinterColumn;\n"))
+		Expect(string(spec)).To(ContainSubstring(
+			"    @PrinterColumn(name = \"Size\")\n    @SpecReplicas\n    private Integer size;\n"))
+
+		status, err := afero.ReadFile(fs.FS, "src/main/java/com/example/v1/MemcachedStatus.java")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(status)).To(ContainSubstring("import io.fabric8.kubernetes.model.annotation.StatusReplicas;\n"))
+		Expect(string(status)).To(ContainSubstring("    @StatusReplicas\n    private Integer replicas;\n"))
+	})
+
+	It("generates no status class without the status subresource", func() {
+		pluginConfig := PluginConfig{Package: "com.example", Language: LanguageKotlin}
+		pluginConfig.SetAPIOptions(res, APIOptions{NoStatus: true})
+		scaffold(pluginConfig)
+
+		Expect(afero.Exists(fs.FS, "src/main/kotlin/com/example/v1/MemcachedStatus.kt")).To(BeFalse())
+		customResource, err := afero.ReadFile(fs.FS, "src/main/kotlin/com/example/v1/Memcached.kt")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(customResource)).To(ContainSubstring("class Memcached : CustomResource<MemcachedSpec, Void>()"))
+	})
+
+	It("generates the typed spec and status classes of the schema", func() {
+		res.Plural = "memcachedes"
+		schema := model.Schema{
+			Spec: model.Properties{Fields: []model.Field{
+				{Name: "size", Type: model.Type{Name: model.TypeInt}, Required: true},
+			}},
+		}
+		scaffoldSchema(PluginConfig{Package: "com.example"}, schema)
+
+		spec, err := afero.ReadFile(fs.FS, "src/main/java/com/example/v1/MemcachedSpec.java")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(spec)).To(ContainSubstring("import io.fabric8.generator.annotation.Required;"))
+		Expect(string(spec)).To(ContainSubstring("    @Required\n    private Integer size;\n"))
+		Expect(string(spec)).To(ContainSubstring("    public void setSize(Integer size) {\n"))
+
+		status, err := afero.ReadFile(fs.FS, "src/main/java/com/example/v1/MemcachedStatus.java")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(status)).To(ContainSubstring("// Add Status information here"))
+
+		customResource, err := afero.ReadFile(fs.FS, "src/main/java/com/example/v1/Memcached.java")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(customResource)).To(ContainSubstring(`@Plural("memcachedes")`))
+	})
+
+	It("generates each version in its own package and moves the storage version", func() {
+		pluginConfig := PluginConfig{Package: "com.example"}
+		pluginConfig.SetStorageVersion(res)
+		Expect(cfg.AddResource(res)).To(Succeed())
+		scaffold(pluginConfig)
+
+		v2 := res
+		v2.Version = "v2"
+		Expect(cfg.AddResource(v2)).To(Succeed())
+		pluginConfig.SetStorageVersion(v2)
+		scaffolder := NewCreateAPIScaffolder(cfg, pluginConfig, v2, model.Schema{})
+		scaffolder.InjectFS(fs)
+		Expect(scaffolder.Scaffold()).To(Succeed())
+
+		// Both versions are written to the same CRD manifest, which is bundled once
+		makefile, err := afero.ReadFile(fs.FS, "Makefile")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(strings.Count(string(makefile), "memcacheds.cache.example.com-v1.yml")).To(Equal(1))
+
+		v1Model, err := afero.ReadFile(fs.FS, "src/main/java/com/example/v1/Memcached.java")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(v1Model)).To(ContainSubstring(`@Version(value = "v1", storage = false, served = true)`))
+		Expect(scaffolder.Edited()).To(ConsistOf("src/main/java/com/example/v1/Memcached.java"))
+
+		v2Model, err := afero.ReadFile(fs.FS, "src/main/java/com/example/v2/Memcached.java")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(v2Model)).To(ContainSubstring("package com.example.v2;"))
+		Expect(string(v2Model)).To(ContainSubstring(`@Version("v2")`))
+
+		// The reconciler of the first version is kept
+		reconciler, err := afero.ReadFile(fs.FS, "src/main/java/com/example/MemcachedReconciler.java")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(reconciler)).To(ContainSubstring("import com.example.v1.Memcached;"))
+	})
+
+	It("regenerates the mappers converting to and from the previous storage version", func() {
+		pluginConfig := PluginConfig{Package: "com.example"}
+		pluginConfig.SetStorageVersion(res)
+		res.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Conversion: true}
+		Expect(cfg.AddResource(res)).To(Succeed())
+		scaffold(pluginConfig)
+		Expect(machinery.NewScaffold(fs).Execute(&templates.PomXmlFile{
+			Package:         "com.example",
+			ProjectName:     "memcached-operator",
+			OperatorVersion: "0.0.1",
+			QuarkusVersion:  DefaultQuarkusVersion,
+		})).To(Succeed())
+		webhookScaffolder := NewCreateWebhookScaffolder(cfg, pluginConfig, res, false)
+		webhookScaffolder.InjectFS(fs)
+		Expect(webhookScaffolder.Scaffold()).To(Succeed())
+
+		v2 := res
+		v2.Version = "v2"
+		v2.Webhooks = nil
+		Expect(cfg.AddResource(v2)).To(Succeed())
+		pluginConfig.SetStorageVersion(v2)
+		scaffolder := NewCreateAPIScaffolder(cfg, pluginConfig, v2, model.Schema{})
+		scaffolder.InjectFS(fs)
+		Expect(scaffolder.Scaffold()).To(Succeed())
+
+		mapper, err := afero.ReadFile(fs.FS, "src/main/java/com/example/v1/MemcachedMapper.java")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(mapper)).To(ContainSubstring(`@TargetVersion("v1")`))
+		Expect(string(mapper)).To(ContainSubstring(
+			"public class MemcachedMapper implements Mapper<Memcached, com.example.v2.Memcached> {"))
+		Expect(scaffolder.Diagnostics()).To(ContainElement(Diagnostic{
+			Path:    "src/main/java/com/example/v1/MemcachedMapper.java",
+			Message: "the mapper was regenerated to convert to and from the new storage version v2",
+		}))
+		Expect(scaffolder.Edited()).To(ConsistOf("src/main/java/com/example/v1/MemcachedMapper.java",
+			"src/main/java/com/example/v1/Memcached.java"))
+	})
+
+	It("generates the classes in the package of the group in multi-group projects", func() {
+		Expect(cfg.SetMultiGroup()).To(Succeed())
+		scaffold(PluginConfig{Package: "com.example"})
+
+		for _, name := range []string{"Memcached", "MemcachedSpec", "MemcachedStatus"} {
+			Expect(afero.Exists(fs.FS, "src/main/java/com/example/cache/v1/"+name+".java")).To(BeTrue())
+		}
+		reconciler, err := afero.ReadFile(fs.FS, "src/main/java/com/example/cache/MemcachedReconciler.java")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(reconciler)).To(ContainSubstring("package com.example.cache;"))
+		Expect(string(reconciler)).To(ContainSubstring("import com.example.cache.v1.Memcached;"))
+	})
+})
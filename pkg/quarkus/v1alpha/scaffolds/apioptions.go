@@ -0,0 +1,239 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffolds
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/model/resource"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/templates/model"
+)
+
+// Types of the printer columns
+const (
+	ColumnInteger = "integer"
+	ColumnNumber  = "number"
+	ColumnString  = "string"
+	ColumnBoolean = "boolean"
+)
+
+// columnFieldTypes maps the types of the printer columns to the types of the fields the CRD generator prints in
+// them, the first one being the type of the fields declared for the columns
+var columnFieldTypes = map[string][]string{
+	ColumnInteger: {model.TypeInt, model.TypeLong},
+	ColumnNumber:  {model.TypeDouble, model.TypeFloat},
+	ColumnString:  {model.TypeString},
+	ColumnBoolean: {model.TypeBoolean},
+}
+
+// fieldPath matches the JSON paths of the properties of the spec and status classes
+var fieldPath = regexp.MustCompile(`^\.(spec|status)\.([A-Za-z][A-Za-z0-9_-]*)$`)
+
+// APIOptions are the options of a version of an API which the CRD generator reads from the annotations of its models
+type APIOptions struct {
+	// PrintColumns are the additional columns kubectl get prints
+	PrintColumns []PrintColumn `json:"printColumns,omitempty"`
+
+	// Scale enables the scale subresource, if set
+	Scale *Scale `json:"scale,omitempty"`
+
+	// NoStatus disables the status subresource, generating no status class
+	NoStatus bool `json:"noStatus,omitempty"`
+}
+
+// PrintColumn is a column kubectl get prints, holding a property of the spec or status class
+type PrintColumn struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	JSONPath string `json:"jsonPath"`
+}
+
+// Scale holds the paths of the desired and actual replicas of the scale subresource
+type Scale struct {
+	SpecReplicasPath   string `json:"specReplicasPath"`
+	StatusReplicasPath string `json:"statusReplicasPath"`
+}
+
+// ParsePrintColumn parses a printer column declared as name:type:jsonPath, the type being one of integer, number,
+// string and boolean and the path the one of a property of the spec or status, e.g. .spec.size
+func ParsePrintColumn(declaration string) (PrintColumn, error) {
+	parts := strings.SplitN(declaration, ":", 3)
+	if len(parts) != 3 || strings.TrimSpace(parts[0]) == "" {
+		return PrintColumn{}, fmt.Errorf("invalid column %q, expected name:type:jsonPath", declaration)
+	}
+
+	column := PrintColumn{
+		Name:     strings.TrimSpace(parts[0]),
+		Type:     strings.TrimSpace(parts[1]),
+		JSONPath: strings.TrimSpace(parts[2]),
+	}
+	if _, ok := columnFieldTypes[column.Type]; !ok {
+		return PrintColumn{}, fmt.Errorf("invalid column %q: unknown type %q, expected %s, %s, %s or %s",
+			declaration, column.Type, ColumnInteger, ColumnNumber, ColumnString, ColumnBoolean)
+	}
+	if _, _, err := splitFieldPath(column.JSONPath); err != nil {
+		return PrintColumn{}, fmt.Errorf("invalid column %q: %w", declaration, err)
+	}
+	return column, nil
+}
+
+// ParseScale parses the scale subresource declared as specReplicasPath:statusReplicasPath,
+// e.g. .spec.replicas:.status.replicas
+func ParseScale(declaration string) (*Scale, error) {
+	parts := strings.Split(declaration, ":")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid scale subresource %q, expected specReplicasPath:statusReplicasPath", declaration)
+	}
+
+	scale := &Scale{SpecReplicasPath: strings.TrimSpace(parts[0]), StatusReplicasPath: strings.TrimSpace(parts[1])}
+	for _, replicas := range []struct{ path, section string }{
+		{scale.SpecReplicasPath, "spec"},
+		{scale.StatusReplicasPath, "status"},
+	} {
+		section, _, err := splitFieldPath(replicas.path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid scale subresource %q: %w", declaration, err)
+		}
+		if section != replicas.section {
+			return nil, fmt.Errorf("invalid scale subresource %q: %s is not a path in the %s", declaration,
+				replicas.path, replicas.section)
+		}
+	}
+	return scale, nil
+}
+
+// splitFieldPath splits the JSON path of a property into the section holding it, spec or status, and its name
+func splitFieldPath(path string) (section, name string, err error) {
+	match := fieldPath.FindStringSubmatch(path)
+	if match == nil {
+		return "", "", fmt.Errorf("path %q is neither .spec.<field> nor .status.<field>, "+
+			"the nested fields being unsupported", path)
+	}
+	return match[1], match[2], nil
+}
+
+// IsEmpty returns true if no option is set
+func (o APIOptions) IsEmpty() bool {
+	return len(o.PrintColumns) == 0 && o.Scale == nil && !o.NoStatus
+}
+
+// Apply returns the schema annotated for the printer columns and the scale subresource. The properties the columns
+// and the scale subresource refer to are declared if missing.
+func (o APIOptions) Apply(schema model.Schema) (model.Schema, error) {
+	// The fields are copied, so that the given schema is left untouched
+	schema.Spec.Fields = append([]model.Field(nil), schema.Spec.Fields...)
+	schema.Status.Fields = append([]model.Field(nil), schema.Status.Fields...)
+	sections := map[string]*model.Properties{"spec": &schema.Spec, "status": &schema.Status}
+
+	if o.NoStatus {
+		switch {
+		case len(schema.Status.Fields) != 0:
+			return model.Schema{}, fmt.Errorf("status fields cannot be declared without the status subresource")
+		case o.Scale != nil:
+			return model.Schema{}, fmt.Errorf("the scale subresource requires the status subresource")
+		}
+		delete(sections, "status")
+	}
+
+	if o.Scale != nil {
+		for _, replicas := range []struct {
+			path string
+			mark func(*model.Field)
+		}{
+			{o.Scale.SpecReplicasPath, func(f *model.Field) { f.SpecReplicas = true }},
+			{o.Scale.StatusReplicasPath, func(f *model.Field) { f.StatusReplicas = true }},
+		} {
+			field, err := lookupField(sections, replicas.path, model.TypeInt, model.TypeLong)
+			if err != nil {
+				return model.Schema{}, fmt.Errorf("invalid scale subresource: %w", err)
+			}
+			replicas.mark(field)
+		}
+	}
+
+	names := map[string]bool{}
+	for _, column := range o.PrintColumns {
+		if names[column.Name] {
+			return model.Schema{}, fmt.Errorf("column %q is declared more than once", column.Name)
+		}
+		names[column.Name] = true
+
+		field, err := lookupField(sections, column.JSONPath, columnFieldTypes[column.Type]...)
+		if err != nil {
+			return model.Schema{}, fmt.Errorf("invalid column %q: %w", column.Name, err)
+		}
+		if field.PrinterColumn != "" {
+			return model.Schema{}, fmt.Errorf("invalid column %q: %s is already printed in column %q",
+				column.Name, column.JSONPath, field.PrinterColumn)
+		}
+		field.PrinterColumn = column.Name
+	}
+
+	return schema, nil
+}
+
+// lookupField returns the property at path, which must have one of the types. The property is declared with the
+// first type if missing.
+func lookupField(sections map[string]*model.Properties, path string, types ...string) (*model.Field, error) {
+	section, name, err := splitFieldPath(path)
+	if err != nil {
+		return nil, err
+	}
+	properties, ok := sections[section]
+	if !ok {
+		return nil, fmt.Errorf("%s is in the status, which is disabled", path)
+	}
+
+	for i := range properties.Fields {
+		field := &properties.Fields[i]
+		if field.Name != name {
+			continue
+		}
+		for _, t := range types {
+			if field.Type.IsScalar() && field.Type.Name == t {
+				return field, nil
+			}
+		}
+		return nil, fmt.Errorf("%s is not a field of type %s", path, strings.Join(types, " or "))
+	}
+
+	properties.Fields = append(properties.Fields, model.Field{Name: name, Type: model.Type{Name: types[0]}})
+	return &properties.Fields[len(properties.Fields)-1], nil
+}
+
+// APIOptions returns the options of the version of the resource
+func (c PluginConfig) APIOptions(res resource.Resource) APIOptions {
+	return c.APIs[apiName(res)]
+}
+
+// SetAPIOptions records the options of the version of the resource
+func (c *PluginConfig) SetAPIOptions(res resource.Resource, options APIOptions) {
+	if options.IsEmpty() {
+		delete(c.APIs, apiName(res))
+		return
+	}
+	if c.APIs == nil {
+		c.APIs = map[string]APIOptions{}
+	}
+	c.APIs[apiName(res)] = options
+}
+
+// apiName returns the name of the version of the resource, i.e. <plural>.<group>/<version>
+func apiName(res resource.Resource) string {
+	return crdName(res) + "/" + res.Version
+}
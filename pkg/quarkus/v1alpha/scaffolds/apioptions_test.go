@@ -0,0 +1,118 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffolds
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/templates/model"
+)
+
+var _ = Describe("APIOptions", func() {
+	It("parses the printer columns", func() {
+		column, err := ParsePrintColumn("Desired Size:integer:.spec.size")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(column).To(Equal(PrintColumn{Name: "Desired Size", Type: ColumnInteger, JSONPath: ".spec.size"}))
+
+		_, err = ParsePrintColumn("Size:integer")
+		Expect(err).To(MatchError(ContainSubstring("expected name:type:jsonPath")))
+		_, err = ParsePrintColumn("Age:date:.metadata.creationTimestamp")
+		Expect(err).To(MatchError(ContainSubstring(`unknown type "date"`)))
+		_, err = ParsePrintColumn("Node:string:.status.nodes[0]")
+		Expect(err).To(MatchError(ContainSubstring("the nested fields being unsupported")))
+	})
+
+	It("parses the scale subresource", func() {
+		scale, err := ParseScale(".spec.replicas:.status.readyReplicas")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*scale).To(Equal(Scale{SpecReplicasPath: ".spec.replicas", StatusReplicasPath: ".status.readyReplicas"}))
+
+		_, err = ParseScale(".spec.replicas")
+		Expect(err).To(MatchError(ContainSubstring("expected specReplicasPath:statusReplicasPath")))
+		_, err = ParseScale(".status.replicas:.spec.replicas")
+		Expect(err).To(MatchError(ContainSubstring(".status.replicas is not a path in the spec")))
+	})
+
+	It("annotates the fields, declaring the missing ones", func() {
+		schema := model.Schema{Spec: model.Properties{Fields: []model.Field{
+			{Name: "size", Type: model.Type{Name: model.TypeInt}, Required: true},
+		}}}
+		options := APIOptions{
+			PrintColumns: []PrintColumn{
+				{Name: "Size", Type: ColumnInteger, JSONPath: ".spec.size"},
+				{Name: "Phase", Type: ColumnString, JSONPath: ".status.phase"},
+			},
+			Scale: &Scale{SpecReplicasPath: ".spec.size", StatusReplicasPath: ".status.replicas"},
+		}
+
+		applied, err := options.Apply(schema)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(applied.Spec.Fields).To(Equal([]model.Field{
+			{Name: "size", Type: model.Type{Name: model.TypeInt}, Required: true, PrinterColumn: "Size", SpecReplicas: true},
+		}))
+		Expect(applied.Status.Fields).To(ConsistOf(
+			model.Field{Name: "replicas", Type: model.Type{Name: model.TypeInt}, StatusReplicas: true},
+			model.Field{Name: "phase", Type: model.Type{Name: model.TypeString}, PrinterColumn: "Phase"},
+		))
+
+		// The given schema is left untouched
+		Expect(schema.Spec.Fields[0].PrinterColumn).To(BeEmpty())
+		Expect(schema.Status.Fields).To(BeEmpty())
+	})
+
+	It("fails on the fields of another type", func() {
+		schema := model.Schema{Spec: model.Properties{Fields: []model.Field{
+			{Name: "image", Type: model.Type{Name: model.TypeString}},
+		}}}
+		_, err := APIOptions{PrintColumns: []PrintColumn{{Name: "Image", Type: ColumnInteger, JSONPath: ".spec.image"}}}.
+			Apply(schema)
+		Expect(err).To(MatchError(`invalid column "Image": .spec.image is not a field of type int or long`))
+
+		_, err = APIOptions{Scale: &Scale{SpecReplicasPath: ".spec.image", StatusReplicasPath: ".status.replicas"}}.
+			Apply(schema)
+		Expect(err).To(MatchError(ContainSubstring("invalid scale subresource")))
+	})
+
+	It("fails on the columns declared twice", func() {
+		_, err := APIOptions{PrintColumns: []PrintColumn{
+			{Name: "Size", Type: ColumnInteger, JSONPath: ".spec.size"},
+			{Name: "Size", Type: ColumnInteger, JSONPath: ".spec.replicas"},
+		}}.Apply(model.Schema{})
+		Expect(err).To(MatchError(`column "Size" is declared more than once`))
+
+		_, err = APIOptions{PrintColumns: []PrintColumn{
+			{Name: "Size", Type: ColumnInteger, JSONPath: ".spec.size"},
+			{Name: "Count", Type: ColumnInteger, JSONPath: ".spec.size"},
+		}}.Apply(model.Schema{})
+		Expect(err).To(MatchError(`invalid column "Count": .spec.size is already printed in column "Size"`))
+	})
+
+	It("refuses the status fields without the status subresource", func() {
+		options := APIOptions{NoStatus: true}
+		_, err := options.Apply(model.Schema{Status: model.Properties{Fields: []model.Field{
+			{Name: "nodes", Type: model.Type{Name: model.TypeString}},
+		}}})
+		Expect(err).To(MatchError(ContainSubstring("status fields cannot be declared")))
+
+		options.PrintColumns = []PrintColumn{{Name: "Phase", Type: ColumnString, JSONPath: ".status.phase"}}
+		_, err = options.Apply(model.Schema{})
+		Expect(err).To(MatchError(ContainSubstring(".status.phase is in the status, which is disabled")))
+
+		options.Scale = &Scale{SpecReplicasPath: ".spec.replicas", StatusReplicasPath: ".status.replicas"}
+		_, err = options.Apply(model.Schema{})
+		Expect(err).To(MatchError(ContainSubstring("the scale subresource requires the status subresource")))
+	})
+})
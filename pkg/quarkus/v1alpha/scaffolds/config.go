@@ -0,0 +1,260 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffolds
+
+import (
+	"sigs.k8s.io/kubebuilder/v3/pkg/model/resource"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/util"
+)
+
+const (
+	// BuildToolMaven builds the project with Maven
+	BuildToolMaven = "maven"
+
+	// BuildToolGradle builds the project with Gradle
+	BuildToolGradle = "gradle"
+
+	// LanguageJava generates the models and reconcilers in Java
+	LanguageJava = "java"
+
+	// LanguageKotlin generates the models and reconcilers in Kotlin
+	LanguageKotlin = "kotlin"
+)
+
+// PluginConfig holds the project-wide settings of the plugin, stored in the PROJECT file
+type PluginConfig struct {
+	// Package is the Java package of the generated sources
+	Package string `json:"package,omitempty"`
+
+	// GroupID and ArtifactID are the Maven coordinates of the project, the package and the project name by default
+	GroupID    string `json:"groupId,omitempty"`
+	ArtifactID string `json:"artifactId,omitempty"`
+
+	// QuarkusVersion is the Quarkus version the project is built with
+	QuarkusVersion string `json:"quarkusVersion,omitempty"`
+
+	// OperatorSDKVersion is the version of the Quarkus extension of the java-operator-sdk
+	OperatorSDKVersion string `json:"operatorSDKVersion,omitempty"`
+
+	// JavaVersion is the Java release the sources are compiled for
+	JavaVersion string `json:"javaVersion,omitempty"`
+
+	// OperatorVersion is the version of the operator, 0.0.1 by default
+	OperatorVersion string `json:"operatorVersion,omitempty"`
+
+	// Native indicates that the operator image is built as a native executable
+	Native bool `json:"native,omitempty"`
+
+	// MainClass indicates that the operator is started by a scaffolded main class
+	// instead of being started implicitly by Quarkus
+	MainClass bool `json:"mainClass,omitempty"`
+
+	// BuildTool is the tool the project is built with, Maven when empty
+	BuildTool string `json:"buildTool,omitempty"`
+
+	// Language is the language the models and reconcilers are generated in, Java when empty
+	Language string `json:"language,omitempty"`
+
+	// StorageVersions maps the names of the CRDs, i.e. <plural>.<group>, to the versions their
+	// custom resources are stored as
+	StorageVersions map[string]string `json:"storageVersions,omitempty"`
+
+	// Names maps the names of the CRDs to the short names and categories their versions share
+	Names map[string]CRDNames `json:"names,omitempty"`
+
+	// APIs maps the versions of the CRDs, i.e. <plural>.<group>/<version>, to their printer columns and subresources
+	APIs map[string]APIOptions `json:"apis,omitempty"`
+
+	// Reconcilers maps the names of the CRDs to the capabilities of the reconcilers of their kinds
+	Reconcilers map[string]ReconcilerOptions `json:"reconcilers,omitempty"`
+}
+
+// ReconcilerOptions are the capabilities of a reconciler beyond the reconciliation of the custom resources
+type ReconcilerOptions struct {
+	// Cleanup cleans up the custom resources on deletion, which adds a finalizer to them
+	Cleanup bool `json:"cleanup,omitempty"`
+
+	// ErrorStatusHandler updates the status of the custom resources when their reconciliation fails
+	ErrorStatusHandler bool `json:"errorStatusHandler,omitempty"`
+
+	// EventSources declares the event sources of the secondary resources the reconciler watches
+	EventSources bool `json:"eventSources,omitempty"`
+}
+
+// IsEmpty returns true if the reconciler has no other capability than reconciling the custom resources
+func (o ReconcilerOptions) IsEmpty() bool {
+	return o == ReconcilerOptions{}
+}
+
+// CRDNames are the names of a CRD which are not derived from its kind
+type CRDNames struct {
+	// ShortNames are the aliases of the plural of the CRD, e.g. for kubectl get
+	ShortNames []string `json:"shortNames,omitempty"`
+
+	// Categories are the groups of resources the CRD belongs to, e.g. for kubectl get all
+	Categories []string `json:"categories,omitempty"`
+}
+
+// IsEmpty returns true if neither short names nor categories are set
+func (n CRDNames) IsEmpty() bool {
+	return len(n.ShortNames) == 0 && len(n.Categories) == 0
+}
+
+// MavenGroupID returns the group ID of the project
+func (c PluginConfig) MavenGroupID() string {
+	if c.GroupID != "" {
+		return c.GroupID
+	}
+	return c.Package
+}
+
+// MavenArtifactID returns the artifact ID of the project named projectName
+func (c PluginConfig) MavenArtifactID(projectName string) string {
+	if c.ArtifactID != "" {
+		return c.ArtifactID
+	}
+	return projectName
+}
+
+// ProjectVersion returns the version of the operator
+func (c PluginConfig) ProjectVersion() string {
+	if c.OperatorVersion != "" {
+		return c.OperatorVersion
+	}
+	return DefaultOperatorVersion
+}
+
+// EENamespace returns the package of the enterprise Java APIs, either javax or jakarta, used by the project
+func (c PluginConfig) EENamespace() string {
+	return eeNamespace(c.QuarkusVersion)
+}
+
+// CleanerInterface returns whether the reconcilers clean up the custom resources by implementing Cleaner
+func (c PluginConfig) CleanerInterface() bool {
+	return cleanerInterface(c.OperatorSDKVersion)
+}
+
+// GenericContext returns whether the reconcilers receive a Context typed by their custom resource
+func (c PluginConfig) GenericContext() bool {
+	return genericContext(c.OperatorSDKVersion)
+}
+
+// BundleGenerator returns the artifact of quarkus-operator-sdk generating the OLM bundle of the project
+func (c PluginConfig) BundleGenerator() string {
+	return bundleGenerator(c.OperatorSDKVersion)
+}
+
+// ResourcePackage returns the package of the reconciler of the resource, which is qualified by the
+// group of the resource in multi-group projects
+func (c PluginConfig) ResourcePackage(res resource.Resource, multiGroup bool) string {
+	if !multiGroup || res.Group == "" {
+		return c.Package
+	}
+	return c.Package + "." + util.SanitizeDomain(res.Group)
+}
+
+// ModelPackage returns the package of the models of the resource, each version of a kind having its own classes
+func (c PluginConfig) ModelPackage(res resource.Resource, multiGroup bool) string {
+	return c.ResourcePackage(res, multiGroup) + "." + res.Version
+}
+
+// StorageVersion returns the storage version of the resource kind, or an empty string if it is unknown
+func (c PluginConfig) StorageVersion(res resource.Resource) string {
+	return c.StorageVersions[crdName(res)]
+}
+
+// SetStorageVersion records the version of the resource as the storage version of its kind
+func (c *PluginConfig) SetStorageVersion(res resource.Resource) {
+	if c.StorageVersions == nil {
+		c.StorageVersions = map[string]string{}
+	}
+	c.StorageVersions[crdName(res)] = res.Version
+}
+
+// CRDNames returns the short names and categories of the resource kind
+func (c PluginConfig) CRDNames(res resource.Resource) CRDNames {
+	return c.Names[crdName(res)]
+}
+
+// SetCRDNames records the short names and categories of the resource kind
+func (c *PluginConfig) SetCRDNames(res resource.Resource, names CRDNames) {
+	if names.IsEmpty() {
+		delete(c.Names, crdName(res))
+		return
+	}
+	if c.Names == nil {
+		c.Names = map[string]CRDNames{}
+	}
+	c.Names[crdName(res)] = names
+}
+
+// ReconcilerOptions returns the capabilities of the reconciler of the resource kind
+func (c PluginConfig) ReconcilerOptions(res resource.Resource) ReconcilerOptions {
+	return c.Reconcilers[crdName(res)]
+}
+
+// SetReconcilerOptions records the capabilities of the reconciler of the resource kind
+func (c *PluginConfig) SetReconcilerOptions(res resource.Resource, options ReconcilerOptions) {
+	if options.IsEmpty() {
+		delete(c.Reconcilers, crdName(res))
+		return
+	}
+	if c.Reconcilers == nil {
+		c.Reconcilers = map[string]ReconcilerOptions{}
+	}
+	c.Reconcilers[crdName(res)] = options
+}
+
+// HasCleanup returns true if any reconciler cleans up its custom resources
+func (c PluginConfig) HasCleanup() bool {
+	for _, options := range c.Reconcilers {
+		if options.Cleanup {
+			return true
+		}
+	}
+	return false
+}
+
+// crdName returns the name of the CRD of the resource
+func crdName(res resource.Resource) string {
+	return res.Plural + "." + res.QualifiedGroup()
+}
+
+// IsKotlin returns true if the models and reconcilers are generated in Kotlin
+func (c PluginConfig) IsKotlin() bool {
+	return c.Language == LanguageKotlin
+}
+
+// IsGradle returns true if the project is built with Gradle
+func (c PluginConfig) IsGradle() bool {
+	return c.BuildTool == BuildToolGradle
+}
+
+// BuildDir returns the directory the build tool writes its output to
+func (c PluginConfig) BuildDir() string {
+	if c.IsGradle() {
+		return "build"
+	}
+	return "target"
+}
+
+// packageCommand returns the command packaging the operator
+func (c PluginConfig) packageCommand() string {
+	if c.IsGradle() {
+		return gradlePackage
+	}
+	return mavenPackage
+}
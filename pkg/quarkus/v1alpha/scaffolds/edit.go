@@ -0,0 +1,191 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffolds
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v3/pkg/plugins"
+)
+
+const (
+	makefileFile = "Makefile"
+
+	mavenPackage       = "mvn package"
+	mavenPackageNative = "mvn package -Pnative"
+
+	gradlePackage       = "gradle build"
+	gradlePackageNative = "gradle build -Dquarkus.package.type=native"
+
+	nativeContainerBuildProperty = "quarkus.native.container-build"
+
+	// applicationScoped is the annotation of the classes the Kotlin all-open compiler plugin opens, without its namespace
+	applicationScoped = ".enterprise.context.ApplicationScoped"
+)
+
+var (
+	gradleJavaVersionRegexp = regexp.MustCompile(`JavaVersion\.(toVersion\("[^"]*"\)|VERSION_\w+)`)
+	gradleJvmTargetRegexp   = regexp.MustCompile(`jvmTarget = "[^"]*"`)
+)
+
+var _ plugins.Scaffolder = &editScaffolder{}
+
+type editScaffolder struct {
+	fs machinery.Filesystem
+
+	config       config.Config
+	pluginConfig PluginConfig
+}
+
+// NewEditScaffolder returns a new plugins.Scaffolder that updates the plugin-owned files to match the given settings
+func NewEditScaffolder(cfg config.Config, pluginConfig PluginConfig) plugins.Scaffolder {
+	return &editScaffolder{
+		config:       cfg,
+		pluginConfig: pluginConfig,
+	}
+}
+
+// InjectFS implements Scaffolder
+func (s *editScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Scaffold implements Scaffolder
+func (s *editScaffolder) Scaffold() error {
+	if s.pluginConfig.IsGradle() {
+		if err := updateFile(s.fs, buildGradleFile, s.updateBuildGradle); err != nil {
+			return err
+		}
+		if err := updateFile(s.fs, gradlePropertiesFile, s.updateGradleProperties); err != nil {
+			return err
+		}
+	} else if err := updateFile(s.fs, pomFile, s.updatePom); err != nil {
+		return err
+	}
+
+	if err := updateFile(s.fs, makefileFile, s.updateMakefile); err != nil {
+		return err
+	}
+
+	return updateFile(s.fs, applicationPropertiesFile, s.updateApplicationProperties)
+}
+
+func (s *editScaffolder) updatePom(pom string) (string, error) {
+	var err error
+	if groupID := s.pluginConfig.MavenGroupID(); groupID != "" {
+		if pom, err = setPomElement(pom, "groupId", groupID); err != nil {
+			return "", err
+		}
+	}
+	if s.pluginConfig.QuarkusVersion != "" {
+		if pom, err = setPomElement(pom, "quarkus.version", s.pluginConfig.QuarkusVersion); err != nil {
+			return "", err
+		}
+	}
+	if s.pluginConfig.OperatorSDKVersion != "" {
+		if pom, err = setPomElement(pom, "quarkus-sdk.version", s.pluginConfig.OperatorSDKVersion); err != nil {
+			return "", err
+		}
+	}
+	if s.pluginConfig.JavaVersion != "" {
+		for _, name := range []string{"maven.compiler.source", "maven.compiler.target"} {
+			if pom, err = setPomElement(pom, name, s.pluginConfig.JavaVersion); err != nil {
+				return "", err
+			}
+		}
+		if strings.Contains(pom, "<jvmTarget>") {
+			if pom, err = setPomElement(pom, "jvmTarget", s.pluginConfig.JavaVersion); err != nil {
+				return "", err
+			}
+		}
+	}
+	pom = setBundleGenerator(pom, s.pluginConfig.BundleGenerator())
+	return setEENamespace(pom, s.pluginConfig.EENamespace()), nil
+}
+
+func (s *editScaffolder) updateBuildGradle(script string) (string, error) {
+	if s.pluginConfig.MavenGroupID() != "" {
+		var err error
+		if script, err = setGradleAssignment(script, "group", s.pluginConfig.MavenGroupID()); err != nil {
+			return "", err
+		}
+	}
+	if s.pluginConfig.JavaVersion != "" {
+		script = gradleJavaVersionRegexp.ReplaceAllString(script, fmt.Sprintf("JavaVersion.toVersion(%q)", s.pluginConfig.JavaVersion))
+		script = gradleJvmTargetRegexp.ReplaceAllString(script, fmt.Sprintf("jvmTarget = %q", s.pluginConfig.JavaVersion))
+	}
+	script = setBundleGenerator(script, s.pluginConfig.BundleGenerator())
+	return setEENamespace(script, s.pluginConfig.EENamespace()), nil
+}
+
+// setEENamespace sets the namespace of the annotation configured for the Kotlin all-open compiler plugin
+func setEENamespace(content, namespace string) string {
+	for _, from := range []string{javaxNamespace, jakartaNamespace} {
+		content = strings.ReplaceAll(content, from+applicationScoped, namespace+applicationScoped)
+	}
+	return content
+}
+
+// setBundleGenerator sets the artifact generating the OLM bundle, which quarkus-operator-sdk 4 renamed
+func setBundleGenerator(content, artifact string) string {
+	for _, from := range []string{csvGeneratorArtifact, bundleGeneratorArtifact} {
+		content = strings.ReplaceAll(content, from, artifact)
+	}
+	return content
+}
+
+func (s *editScaffolder) updateGradleProperties(properties string) (string, error) {
+	if s.pluginConfig.QuarkusVersion != "" {
+		properties = setProperty(properties, "quarkusPluginVersion", s.pluginConfig.QuarkusVersion)
+		properties = setProperty(properties, "quarkusVersion", s.pluginConfig.QuarkusVersion)
+	}
+	if s.pluginConfig.OperatorSDKVersion != "" {
+		properties = setProperty(properties, "quarkusOperatorSdkVersion", s.pluginConfig.OperatorSDKVersion)
+	}
+	return properties, nil
+}
+
+func (s *editScaffolder) updateMakefile(makefile string) (string, error) {
+	packageCommand, nativeCommand := mavenPackage, mavenPackageNative
+	if s.pluginConfig.IsGradle() {
+		packageCommand, nativeCommand = gradlePackage, gradlePackageNative
+	}
+
+	lines := strings.Split(makefile, "\n")
+	for i, line := range lines {
+		if !strings.HasPrefix(strings.TrimSpace(line), packageCommand) {
+			continue
+		}
+
+		line = strings.Replace(line, nativeCommand, packageCommand, 1)
+		if s.pluginConfig.Native {
+			line = strings.Replace(line, packageCommand, nativeCommand, 1)
+		}
+		lines[i] = line
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+func (s *editScaffolder) updateApplicationProperties(properties string) (string, error) {
+	if s.pluginConfig.Native {
+		// Build the native executable inside a container so that no local GraalVM installation is required
+		return setProperty(properties, nativeContainerBuildProperty, "true"), nil
+	}
+	return removeProperty(properties, nativeContainerBuildProperty), nil
+}
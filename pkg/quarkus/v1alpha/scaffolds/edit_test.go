@@ -0,0 +1,112 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffolds
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	v3 "sigs.k8s.io/kubebuilder/v3/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/scaffolds/internal/templates"
+)
+
+var _ = Describe("editScaffolder", func() {
+	var fs machinery.Filesystem
+
+	readFile := func(path string) string {
+		contents, err := afero.ReadFile(fs.FS, path)
+		Expect(err).NotTo(HaveOccurred())
+		return string(contents)
+	}
+
+	edit := func(pluginConfig PluginConfig) {
+		scaffolder := NewEditScaffolder(v3.New(), pluginConfig)
+		scaffolder.InjectFS(fs)
+		Expect(scaffolder.Scaffold()).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		Expect(machinery.NewScaffold(fs).Execute(
+			&templates.PomXmlFile{
+				Package:         "com.example",
+				ProjectName:     "memcached-operator",
+				OperatorVersion: "0.0.1",
+				QuarkusVersion:  DefaultQuarkusVersion,
+			},
+			&templates.ApplicationPropertiesFile{ProjectName: "memcached-operator"},
+			&templates.Makefile{KustomizeVersion: "v3.5.4", OperatorVersion: DefaultOperatorVersion},
+		)).To(Succeed())
+	})
+
+	It("updates the group id and the Quarkus version", func() {
+		edit(PluginConfig{Package: "com.acme.operators", QuarkusVersion: "2.7.6.Final"})
+
+		pom := readFile("pom.xml")
+		Expect(pom).To(ContainSubstring("<groupId>com.acme.operators</groupId>"))
+		Expect(pom).To(ContainSubstring("<quarkus.version>2.7.6.Final</quarkus.version>"))
+		Expect(pom).To(ContainSubstring("<groupId>io.quarkiverse.operatorsdk</groupId>"))
+	})
+
+	It("updates the quarkus-operator-sdk and Java versions and the namespace of the all-open annotation", func() {
+		Expect(fs.FS.Remove("pom.xml")).To(Succeed())
+		Expect(machinery.NewScaffold(fs).Execute(&templates.PomXmlFile{
+			Package:         "com.example",
+			ProjectName:     "memcached-operator",
+			OperatorVersion: "0.0.1",
+			QuarkusVersion:  DefaultQuarkusVersion,
+			Kotlin:          true,
+		})).To(Succeed())
+
+		edit(PluginConfig{Package: "com.example", QuarkusVersion: "3.8.4", OperatorSDKVersion: "6.6.4", JavaVersion: "17"})
+
+		pom := readFile("pom.xml")
+		Expect(pom).To(ContainSubstring("<quarkus-sdk.version>6.6.4</quarkus-sdk.version>"))
+		Expect(pom).To(ContainSubstring("<maven.compiler.source>17</maven.compiler.source>"))
+		Expect(pom).To(ContainSubstring("<maven.compiler.target>17</maven.compiler.target>"))
+		Expect(pom).To(ContainSubstring("<jvmTarget>17</jvmTarget>"))
+		Expect(pom).To(ContainSubstring("all-open:annotation=jakarta.enterprise.context.ApplicationScoped"))
+		Expect(pom).To(ContainSubstring("<artifactId>quarkus-operator-sdk-bundle-generator</artifactId>"))
+		Expect(pom).NotTo(ContainSubstring("csv-generator"))
+	})
+
+	It("toggles the native build", func() {
+		edit(PluginConfig{Package: "com.example", Native: true})
+		Expect(readFile("Makefile")).To(ContainSubstring("\tmvn package -Pnative -Dquarkus.container-image.build=true"))
+		Expect(readFile("Makefile")).To(ContainSubstring("\tmvn package -Pnative -Dquarkus.container-image.push=true"))
+		Expect(readFile(applicationPropertiesFile)).To(ContainSubstring("quarkus.native.container-build=true\n"))
+
+		// Editing again with the same settings is a no-op
+		edit(PluginConfig{Package: "com.example", Native: true})
+		Expect(readFile("Makefile")).NotTo(ContainSubstring("-Pnative -Pnative"))
+
+		edit(PluginConfig{Package: "com.example"})
+		Expect(readFile("Makefile")).NotTo(ContainSubstring("-Pnative"))
+		Expect(readFile(applicationPropertiesFile)).NotTo(ContainSubstring("quarkus.native.container-build"))
+	})
+})
+
+var _ = Describe("properties", func() {
+	It("sets and removes properties", func() {
+		contents := "# a comment\nfoo=bar\n"
+		Expect(setProperty(contents, "foo", "baz")).To(Equal("# a comment\nfoo=baz\n"))
+		Expect(setProperty(contents, "other", "value")).To(Equal("# a comment\nfoo=bar\nother=value\n"))
+		Expect(setProperty("foo=bar", "other", "value")).To(Equal("foo=bar\nother=value\n"))
+		Expect(removeProperty(contents, "foo")).To(Equal("# a comment\n"))
+		Expect(removeProperty(contents, "other")).To(Equal(contents))
+	})
+})
@@ -0,0 +1,49 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffolds
+
+import (
+	"fmt"
+	iofs "io/fs"
+
+	"github.com/spf13/afero"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+// updateFile rewrites the file at path with the result of applying update to its contents.
+// The file is left untouched if its contents do not change.
+func updateFile(fs machinery.Filesystem, path string, update func(string) (string, error)) error {
+	contents, err := afero.ReadFile(fs.FS, path)
+	if err != nil {
+		return fmt.Errorf("error reading %s: %w", path, err)
+	}
+
+	updated, err := update(string(contents))
+	if err != nil {
+		return fmt.Errorf("error updating %s: %w", path, err)
+	}
+	if updated == string(contents) {
+		return nil
+	}
+
+	var mode iofs.FileMode = 0644
+	if info, err := fs.FS.Stat(path); err == nil {
+		mode = info.Mode()
+	}
+	if err := afero.WriteFile(fs.FS, path, []byte(updated), mode); err != nil {
+		return fmt.Errorf("error updating %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffolds
+
+import (
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+const (
+	buildGradleFile      = "build.gradle.kts"
+	gradlePropertiesFile = "gradle.properties"
+)
+
+// updateGradle adds the given properties to gradle.properties and the given dependencies, in
+// "group:artifact:version" notation, to the dependencies block of build.gradle.kts.
+// Properties and dependencies which are already declared are left untouched.
+func updateGradle(fs machinery.Filesystem, properties []pomProperty, dependencies []string) error {
+	err := updateFile(fs, gradlePropertiesFile, func(content string) (string, error) {
+		for _, property := range properties {
+			if !hasProperty(content, property.Name) {
+				content = setProperty(content, property.Name, property.Value)
+			}
+		}
+		return content, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return updateFile(fs, buildGradleFile, func(script string) (string, error) {
+		var err error
+		for _, dependency := range dependencies {
+			if script, err = addGradleDependency(script, dependency); err != nil {
+				return "", err
+			}
+		}
+		return script, nil
+	})
+}
+
+// addGradleDependency adds the dependency at the end of the top-level dependencies block
+func addGradleDependency(script, dependency string) (string, error) {
+	declaration := fmt.Sprintf("implementation(%q)", dependency)
+	if strings.Contains(script, declaration) {
+		return script, nil
+	}
+
+	start := strings.Index(script, "\ndependencies {")
+	if start == -1 {
+		return "", fmt.Errorf("unable to find the dependencies block in %s", buildGradleFile)
+	}
+	end := strings.Index(script[start:], "\n}")
+	if end == -1 {
+		return "", fmt.Errorf("unable to find the end of the dependencies block in %s", buildGradleFile)
+	}
+	end += start + 1
+
+	return script[:end] + "    " + declaration + "\n" + script[end:], nil
+}
+
+// setGradleAssignment sets the value of a top-level string assignment such as `group = "..."`
+func setGradleAssignment(script, name, value string) (string, error) {
+	lines := strings.Split(script, "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, name+" =") || strings.HasPrefix(line, name+"=") {
+			lines[i] = fmt.Sprintf("%s = %q", name, value)
+			return strings.Join(lines, "\n"), nil
+		}
+	}
+	return "", fmt.Errorf("unable to find %s in %s", name, buildGradleFile)
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffolds
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	v3 "sigs.k8s.io/kubebuilder/v3/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/scaffolds/internal/templates"
+)
+
+var _ = Describe("Gradle projects", func() {
+	var fs machinery.Filesystem
+
+	readFile := func(path string) string {
+		contents, err := afero.ReadFile(fs.FS, path)
+		Expect(err).NotTo(HaveOccurred())
+		return string(contents)
+	}
+
+	BeforeEach(func() {
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		Expect(machinery.NewScaffold(fs).Execute(
+			&templates.BuildGradleFile{Package: "com.example", OperatorVersion: "0.0.1"},
+			&templates.GradlePropertiesFile{QuarkusVersion: DefaultQuarkusVersion},
+			&templates.ApplicationPropertiesFile{ProjectName: "memcached-operator"},
+			&templates.Makefile{KustomizeVersion: "v3.5.4", PackageCommand: gradlePackage, BuildDir: "build",
+				OperatorVersion: DefaultOperatorVersion},
+		)).To(Succeed())
+	})
+
+	It("adds properties and dependencies once", func() {
+		for i := 0; i < 2; i++ {
+			Expect(updateGradle(fs,
+				[]pomProperty{{Name: "fooVersion", Value: "1.0.0"}},
+				[]string{"com.example:foo:${property(\"fooVersion\")}"},
+			)).To(Succeed())
+		}
+
+		Expect(readFile("gradle.properties")).To(HaveSuffix("fooVersion=1.0.0\n"))
+		script := readFile("build.gradle.kts")
+		Expect(script).To(ContainSubstring(
+			"    implementation(\"io.quarkus:quarkus-micrometer-registry-prometheus:${quarkusVersion}\")\n" +
+				"    implementation(\"com.example:foo:${property(\\\"fooVersion\\\")}\")\n}\n"))
+	})
+
+	It("updates the group, the Quarkus version and the native build", func() {
+		scaffolder := NewEditScaffolder(v3.New(), PluginConfig{
+			Package:        "com.acme.operators",
+			QuarkusVersion: "2.7.6.Final",
+			Native:         true,
+			BuildTool:      BuildToolGradle,
+		})
+		scaffolder.InjectFS(fs)
+		Expect(scaffolder.Scaffold()).To(Succeed())
+
+		Expect(readFile("build.gradle.kts")).To(ContainSubstring("\ngroup = \"com.acme.operators\"\n"))
+		Expect(readFile("gradle.properties")).To(ContainSubstring("quarkusPluginVersion=2.7.6.Final\nquarkusVersion=2.7.6.Final\n"))
+		Expect(readFile("Makefile")).To(ContainSubstring(
+			"\tgradle build -Dquarkus.package.type=native -Dquarkus.container-image.build=true"))
+	})
+})
@@ -15,14 +15,13 @@
 package scaffolds
 
 import (
-	"os"
 	"path/filepath"
 
-	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/util"
 	"sigs.k8s.io/kubebuilder/v3/pkg/config"
 	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
 
 	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/scaffolds/internal/templates"
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/util"
 	"sigs.k8s.io/kubebuilder/v3/pkg/plugins"
 )
 
@@ -31,6 +30,15 @@ const (
 	kustomizeVersion = "v3.5.4"
 
 	imageName = "controller:latest"
+
+	// DefaultQuarkusVersion is the Quarkus version new projects are built with
+	DefaultQuarkusVersion = "2.7.5.Final"
+
+	// DefaultOperatorSDKVersion is the version of the Quarkus extension of the java-operator-sdk new projects use
+	DefaultOperatorSDKVersion = templates.DefaultOperatorSDKVersion
+
+	// DefaultJavaVersion is the Java release new projects are compiled for
+	DefaultJavaVersion = templates.DefaultJavaVersion
 )
 
 // This file represents the scaffolding done by this init command
@@ -38,14 +46,20 @@ const (
 var _ plugins.Scaffolder = &initScaffolder{}
 
 type initScaffolder struct {
-	fs     machinery.Filesystem
-	config config.Config
+	fs           machinery.Filesystem
+	config       config.Config
+	pluginConfig PluginConfig
+
+	// force indicates whether to overwrite the files of the project if they exist
+	force bool
 }
 
 // NewInitScaffolder returns a new plugins.Scaffolder for project initialization operations
-func NewInitScaffolder(config config.Config) plugins.Scaffolder {
+func NewInitScaffolder(config config.Config, pluginConfig PluginConfig, force bool) plugins.Scaffolder {
 	return &initScaffolder{
-		config: config,
+		config:       config,
+		pluginConfig: pluginConfig,
+		force:        force,
 	}
 }
 
@@ -65,23 +79,94 @@ func (s *initScaffolder) Scaffold() error {
 	)
 
 	path := filepath.Join("src", "main", "java")
+	if s.pluginConfig.IsKotlin() {
+		path = filepath.Join("src", "main", "kotlin")
+	}
 
-	if err := os.MkdirAll(path, 0755); err != nil {
+	if err := s.fs.FS.MkdirAll(path, 0755); err != nil {
 		return err
 	}
-	return scaffold.Execute(
-		&templates.PomXmlFile{
-			Package:         util.ReverseDomain(util.SanitizeDomain(s.config.GetDomain())),
-			ProjectName:     s.config.GetProjectName(),
-			OperatorVersion: "0.0.1",
+
+	var initTemplates []machinery.Builder
+	if s.pluginConfig.IsGradle() {
+		initTemplates = append(initTemplates,
+			&templates.BuildGradleFile{
+				Package:         s.pluginConfig.Package,
+				GroupID:         s.pluginConfig.MavenGroupID(),
+				OperatorVersion: s.pluginConfig.ProjectVersion(),
+				JavaVersion:     s.pluginConfig.JavaVersion,
+				Kotlin:          s.pluginConfig.IsKotlin(),
+				EENamespace:     s.pluginConfig.EENamespace(),
+				BundleGenerator: s.pluginConfig.BundleGenerator(),
+				Force:           s.force,
+			},
+			&templates.SettingsGradleFile{
+				ProjectName: s.pluginConfig.MavenArtifactID(s.config.GetProjectName()),
+				Force:       s.force,
+			},
+			&templates.GradlePropertiesFile{
+				QuarkusVersion:     s.pluginConfig.QuarkusVersion,
+				OperatorSDKVersion: s.pluginConfig.OperatorSDKVersion,
+				Force:              s.force,
+			},
+		)
+	} else {
+		initTemplates = append(initTemplates, &templates.PomXmlFile{
+			Package:            s.pluginConfig.Package,
+			ProjectName:        s.config.GetProjectName(),
+			GroupID:            s.pluginConfig.MavenGroupID(),
+			ArtifactID:         s.pluginConfig.MavenArtifactID(s.config.GetProjectName()),
+			OperatorVersion:    s.pluginConfig.ProjectVersion(),
+			QuarkusVersion:     s.pluginConfig.QuarkusVersion,
+			OperatorSDKVersion: s.pluginConfig.OperatorSDKVersion,
+			JavaVersion:        s.pluginConfig.JavaVersion,
+			Kotlin:             s.pluginConfig.IsKotlin(),
+			EENamespace:        s.pluginConfig.EENamespace(),
+			BundleGenerator:    s.pluginConfig.BundleGenerator(),
+			Force:              s.force,
+		})
+	}
+
+	initTemplates = append(initTemplates,
+		&templates.GitIgnore{
+			Gradle: s.pluginConfig.IsGradle(),
+			Force:  s.force,
 		},
-		&templates.GitIgnore{},
 		&templates.ApplicationPropertiesFile{
 			ProjectName: s.config.GetProjectName(),
+			MainClass:   s.pluginConfig.MainClass,
+			Bundle:      s.pluginConfig.BundleGenerator() == bundleGeneratorArtifact,
+			Force:       s.force,
 		},
 		&templates.Makefile{
 			Image:            "",
 			KustomizeVersion: "v3.5.4",
+			PackageCommand:   s.pluginConfig.packageCommand(),
+			BuildDir:         s.pluginConfig.BuildDir(),
+			OperatorVersion:  s.pluginConfig.ProjectVersion(),
+			Force:            s.force,
 		},
 	)
+	if s.pluginConfig.MainClass {
+		operatorName, _ := OperatorClassName(s.config.GetProjectName())
+		initTemplates = append(initTemplates, &templates.OperatorFile{
+			Package:      s.pluginConfig.Package,
+			OperatorName: operatorName,
+			EENamespace:  s.pluginConfig.EENamespace(),
+			Kotlin:       s.pluginConfig.IsKotlin(),
+			Force:        s.force,
+		})
+	}
+
+	return scaffold.Execute(initTemplates...)
+}
+
+// OperatorClassName returns the name the main class of the operator is derived from, which is the project name
+// turned into a legal Java identifier
+func OperatorClassName(projectName string) (string, *util.Warning) {
+	className, warning := util.SanitizeIdentifier(util.ToClassname(projectName))
+	if warning != nil {
+		warning.Name = projectName
+	}
+	return className, warning
 }
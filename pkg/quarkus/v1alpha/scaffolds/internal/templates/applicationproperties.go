@@ -19,7 +19,7 @@ import (
 
 	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
 
-	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/scaffolds/internal/templates/util"
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/templates/util"
 )
 
 var _ machinery.Template = &ApplicationPropertiesFile{}
@@ -28,6 +28,16 @@ type ApplicationPropertiesFile struct {
 	machinery.TemplateMixin
 	OrgName     string
 	ProjectName string
+
+	// MainClass indicates that the operator is started by the main class instead of by Quarkus
+	MainClass bool
+
+	// Bundle indicates that the OLM bundle is generated by the bundle generator, which replaced the csv generator
+	// in quarkus-operator-sdk 4
+	Bundle bool
+
+	// Force overwrites an already existing application.properties instead of failing
+	Force bool
 }
 
 func (f *ApplicationPropertiesFile) SetTemplateDefaults() error {
@@ -41,6 +51,12 @@ func (f *ApplicationPropertiesFile) SetTemplateDefaults() error {
 
 	f.TemplateBody = ApplicationPropertiesTemplate
 
+	if f.Force {
+		f.IfExistsAction = machinery.OverwriteFile
+	} else {
+		f.IfExistsAction = machinery.Error
+	}
+
 	return nil
 }
 
@@ -49,6 +65,15 @@ const ApplicationPropertiesTemplate = `quarkus.container-image.build=true
 quarkus.container-image.name={{ .ProjectName }}-operator
 # set to true to automatically apply CRDs to the cluster when they get regenerated
 quarkus.operator-sdk.crd.apply=false
+{{- if .Bundle }}
+# set to true to automatically generate the OLM bundle from your code
+quarkus.operator-sdk.bundle.enabled=false
+{{- else }}
 # set to true to automatically generate CSV from your code
 quarkus.operator-sdk.generate-csv=false
+{{- end }}
+{{- if .MainClass }}
+# the operator is started by the main class
+quarkus.operator-sdk.start-operator=false
+{{- end }}
 `
@@ -0,0 +1,136 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package templates
+
+import (
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+var _ machinery.Template = &BuildGradleFile{}
+
+// BuildGradleFile scaffolds the Gradle build script
+type BuildGradleFile struct {
+	machinery.TemplateMixin
+
+	// Package is the source files package
+	Package         string
+	OperatorVersion string
+
+	// GroupID is the group of the project, the package by default
+	GroupID string
+
+	// JavaVersion is the Java release the sources are compiled for
+	JavaVersion string
+
+	// Kotlin indicates that the project compiles Kotlin sources along with the Java ones
+	Kotlin bool
+
+	// EENamespace is the package of the enterprise Java APIs, javax by default or jakarta as of Quarkus 3
+	EENamespace string
+
+	// BundleGenerator is the artifact generating the OLM bundle, the csv generator by default or the bundle
+	// generator as of quarkus-operator-sdk 4
+	BundleGenerator string
+
+	// Force overwrites an already existing build.gradle.kts instead of failing
+	Force bool
+}
+
+func (f *BuildGradleFile) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = "build.gradle.kts"
+	}
+
+	f.TemplateBody = buildGradleTemplate
+
+	if f.Force {
+		f.IfExistsAction = machinery.OverwriteFile
+	} else {
+		f.IfExistsAction = machinery.Error
+	}
+
+	if f.GroupID == "" {
+		f.GroupID = f.Package
+	}
+	if f.JavaVersion == "" {
+		f.JavaVersion = DefaultJavaVersion
+	}
+	if f.EENamespace == "" {
+		f.EENamespace = "javax"
+	}
+	if f.BundleGenerator == "" {
+		f.BundleGenerator = "quarkus-operator-sdk-csv-generator"
+	}
+
+	return nil
+}
+
+const buildGradleTemplate = `
+{{- if .Kotlin -}}
+import org.jetbrains.kotlin.gradle.tasks.KotlinCompile
+
+{{ end -}}
+plugins {
+    java
+{{- if .Kotlin }}
+    kotlin("jvm") version "1.6.21"
+    kotlin("plugin.allopen") version "1.6.21"
+{{- end }}
+    id("io.quarkus")
+}
+
+repositories {
+    mavenCentral()
+    mavenLocal()
+}
+
+val quarkusVersion: String by project
+val quarkusOperatorSdkVersion: String by project
+
+dependencies {
+    implementation(enforcedPlatform("io.quarkiverse.operatorsdk:quarkus-operator-sdk-bom:${quarkusOperatorSdkVersion}"))
+    implementation("io.quarkiverse.operatorsdk:quarkus-operator-sdk")
+    implementation("io.quarkiverse.operatorsdk:{{ .BundleGenerator }}")
+    implementation("io.quarkus:quarkus-micrometer-registry-prometheus:${quarkusVersion}")
+{{- if .Kotlin }}
+    implementation("io.quarkus:quarkus-kotlin:${quarkusVersion}")
+    implementation(kotlin("stdlib-jdk8"))
+{{- end }}
+}
+
+group = "{{ .GroupID }}"
+version = "{{ .OperatorVersion }}-SNAPSHOT"
+
+java {
+    sourceCompatibility = JavaVersion.toVersion("{{ .JavaVersion }}")
+    targetCompatibility = JavaVersion.toVersion("{{ .JavaVersion }}")
+}
+
+tasks.withType<JavaCompile> {
+    options.encoding = "UTF-8"
+    options.compilerArgs.add("-parameters")
+}
+{{- if .Kotlin }}
+
+allOpen {
+    annotation("{{ .EENamespace }}.enterprise.context.ApplicationScoped")
+}
+
+tasks.withType<KotlinCompile> {
+    kotlinOptions.jvmTarget = "{{ .JavaVersion }}"
+    kotlinOptions.javaParameters = true
+}
+{{- end }}
+`
@@ -16,10 +16,12 @@ package controller
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
 
-	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/scaffolds/internal/templates/util"
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/templates/util"
 )
 
 var _ machinery.Template = &Controller{}
@@ -30,8 +32,28 @@ type Controller struct {
 	// Package is the source files package
 	Package string
 
+	// ModelPackage is the package of the custom resource class, the source files package if empty
+	ModelPackage string
+
 	// Name of the operator used for the main file.
 	ClassName string
+
+	// Kotlin indicates that the source file is written in Kotlin instead of Java
+	Kotlin bool
+
+	// GenericContext indicates that the reconciler receives a Context typed by its custom resource,
+	// as it does as of java-operator-sdk 3
+	GenericContext bool
+
+	// Cleanup cleans up the custom resources on deletion, by implementing Cleaner if CleanerInterface is set,
+	// as of java-operator-sdk 4, or by overriding the cleanup method of Reconciler otherwise
+	Cleanup          bool
+	CleanerInterface bool
+
+	// ErrorStatusHandler and EventSources implement ErrorStatusHandler and EventSourceInitializer, which both
+	// require GenericContext
+	ErrorStatusHandler bool
+	EventSources       bool
 }
 
 func (f *Controller) SetTemplateDefaults() error {
@@ -39,6 +61,14 @@ func (f *Controller) SetTemplateDefaults() error {
 		return fmt.Errorf("invalid model name")
 	}
 
+	if f.Kotlin {
+		if f.Path == "" {
+			f.Path = util.PrependKotlinPath(f.ClassName+"Reconciler.kt", util.AsPath(f.Package))
+		}
+		f.TemplateBody = controllerKotlinTemplate
+		return nil
+	}
+
 	if f.Path == "" {
 		f.Path = util.PrependJavaPath(f.ClassName+"Reconciler.java", util.AsPath(f.Package))
 	}
@@ -48,14 +78,74 @@ func (f *Controller) SetTemplateDefaults() error {
 	return nil
 }
 
-const controllerTemplate = `package {{ .Package }};
+// ImportsModel returns true if the custom resource class is in another package
+func (f *Controller) ImportsModel() bool {
+	return f.ModelPackage != "" && f.ModelPackage != f.Package
+}
+
+// Imports returns the sorted imports of the reconciler, besides the custom resource class
+func (f *Controller) Imports() []string {
+	imports := []string{
+		"io.fabric8.kubernetes.client.KubernetesClient",
+		reconcilerPackage + ".Context",
+		reconcilerPackage + ".Reconciler",
+		reconcilerPackage + ".UpdateControl",
+	}
+	if f.Cleanup {
+		imports = append(imports, reconcilerPackage+".DeleteControl")
+		if f.CleanerInterface {
+			imports = append(imports, reconcilerPackage+".Cleaner")
+		}
+	}
+	if f.ErrorStatusHandler {
+		imports = append(imports, reconcilerPackage+".ErrorStatusHandler", reconcilerPackage+".ErrorStatusUpdateControl")
+	}
+	if f.EventSources {
+		imports = append(imports, reconcilerPackage+".EventSourceContext", reconcilerPackage+".EventSourceInitializer",
+			"io.javaoperatorsdk.operator.processing.event.source.EventSource")
+		if !f.Kotlin {
+			imports = append(imports, "java.util.Map")
+		}
+	}
+	sort.Strings(imports)
+	return imports
+}
 
-import io.fabric8.kubernetes.client.KubernetesClient;
-import io.javaoperatorsdk.operator.api.reconciler.Context;
-import io.javaoperatorsdk.operator.api.reconciler.Reconciler;
-import io.javaoperatorsdk.operator.api.reconciler.UpdateControl;
+// Interfaces returns the interfaces the reconciler implements
+func (f *Controller) Interfaces() string {
+	interfaces := []string{"Reconciler"}
+	if f.Cleanup && f.CleanerInterface {
+		interfaces = append(interfaces, "Cleaner")
+	}
+	if f.ErrorStatusHandler {
+		interfaces = append(interfaces, "ErrorStatusHandler")
+	}
+	if f.EventSources {
+		interfaces = append(interfaces, "EventSourceInitializer")
+	}
+	for i, name := range interfaces {
+		interfaces[i] = name + "<" + f.ClassName + ">"
+	}
+	return strings.Join(interfaces, ", ")
+}
+
+// ContextType returns the type of the Context parameters
+func (f *Controller) ContextType() string {
+	if f.GenericContext {
+		return "Context<" + f.ClassName + ">"
+	}
+	return "Context"
+}
+
+const reconcilerPackage = "io.javaoperatorsdk.operator.api.reconciler"
+
+const controllerTemplate = `package {{ .Package }};
 
-public class {{ .ClassName }}Reconciler implements Reconciler<{{ .ClassName }}> { 
+{{ if .ImportsModel }}import {{ .ModelPackage }}.{{ .ClassName }};
+{{ end -}}
+{{ range .Imports }}import {{ . }};
+{{ end }}
+public class {{ .ClassName }}Reconciler implements {{ .Interfaces }} { 
   private final KubernetesClient client;
 
   public {{ .ClassName }}Reconciler(KubernetesClient client) {
@@ -65,11 +155,80 @@ public class {{ .ClassName }}Reconciler implements Reconciler<{{ .ClassName }}>
   // TODO Fill in the rest of the reconciler
 
   @Override
-  public UpdateControl<{{ .ClassName }}> reconcile({{ .ClassName }} resource, Context context) {
+  public UpdateControl<{{ .ClassName }}> reconcile({{ .ClassName }} resource, {{ .ContextType }} context) {
     // TODO: fill in logic
 
     return UpdateControl.noUpdate();
   }
+{{- if .Cleanup }}
+
+  @Override
+  public DeleteControl cleanup({{ .ClassName }} resource, {{ .ContextType }} context) {
+    // TODO: clean up what the reconciler created outside of the cluster, the finalizer being removed afterwards
+
+    return DeleteControl.defaultDelete();
+  }
+{{- end }}
+{{- if .ErrorStatusHandler }}
+
+  @Override
+  public ErrorStatusUpdateControl<{{ .ClassName }}> updateErrorStatus({{ .ClassName }} resource, {{ .ContextType }} context, Exception e) {
+    // TODO: record the error in the status of the resource
+
+    return ErrorStatusUpdateControl.noStatusUpdate();
+  }
+{{- end }}
+{{- if .EventSources }}
+
+  @Override
+  public Map<String, EventSource> prepareEventSources(EventSourceContext<{{ .ClassName }}> context) {
+    // TODO: declare the event sources of the secondary resources to watch
+
+    return Map.of();
+  }
+{{- end }}
 }
 
 `
+
+const controllerKotlinTemplate = `package {{ .Package }}
+
+{{ if .ImportsModel }}import {{ .ModelPackage }}.{{ .ClassName }}
+{{ end -}}
+{{ range .Imports }}import {{ . }}
+{{ end }}
+class {{ .ClassName }}Reconciler(private val client: KubernetesClient) : {{ .Interfaces }} {
+
+    // TODO Fill in the rest of the reconciler
+
+    override fun reconcile(resource: {{ .ClassName }}, context: {{ .ContextType }}): UpdateControl<{{ .ClassName }}> {
+        // TODO: fill in logic
+
+        return UpdateControl.noUpdate()
+    }
+{{- if .Cleanup }}
+
+    override fun cleanup(resource: {{ .ClassName }}, context: {{ .ContextType }}): DeleteControl {
+        // TODO: clean up what the reconciler created outside of the cluster, the finalizer being removed afterwards
+
+        return DeleteControl.defaultDelete()
+    }
+{{- end }}
+{{- if .ErrorStatusHandler }}
+
+    override fun updateErrorStatus(resource: {{ .ClassName }}, context: {{ .ContextType }}, e: Exception): ErrorStatusUpdateControl<{{ .ClassName }}> {
+        // TODO: record the error in the status of the resource
+
+        return ErrorStatusUpdateControl.noStatusUpdate()
+    }
+{{- end }}
+{{- if .EventSources }}
+
+    override fun prepareEventSources(context: EventSourceContext<{{ .ClassName }}>): Map<String, EventSource> {
+        // TODO: declare the event sources of the secondary resources to watch
+
+        return mapOf()
+    }
+{{- end }}
+}
+`
@@ -23,6 +23,12 @@ var _ machinery.Template = &GitIgnore{}
 // GitIgnore scaffolds the .gitignore file
 type GitIgnore struct {
 	machinery.TemplateMixin
+
+	// Gradle indicates that the project is built with Gradle instead of Maven
+	Gradle bool
+
+	// Force overwrites an already existing .gitignore instead of failing
+	Force bool
 }
 
 // SetTemplateDefaults implements input.Template
@@ -33,6 +39,12 @@ func (f *GitIgnore) SetTemplateDefaults() error {
 
 	f.TemplateBody = gitignoreTemplate
 
+	if f.Force {
+		f.IfExistsAction = machinery.OverwriteFile
+	} else {
+		f.IfExistsAction = machinery.Error
+	}
+
 	return nil
 }
 
@@ -44,7 +56,12 @@ const gitignoreTemplate = `
 *.so
 *.dylib
 bin
+{{- if .Gradle }}
+build
+.gradle
+{{- else }}
 target
+{{- end }}
 
 # editor and IDE paraphernalia
 .idea
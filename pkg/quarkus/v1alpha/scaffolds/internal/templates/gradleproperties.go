@@ -0,0 +1,65 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package templates
+
+import (
+	"errors"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+var _ machinery.Template = &GradlePropertiesFile{}
+
+// GradlePropertiesFile scaffolds the Gradle properties holding the dependency versions
+type GradlePropertiesFile struct {
+	machinery.TemplateMixin
+
+	// QuarkusVersion is the version of Quarkus used to build the project
+	QuarkusVersion string
+
+	// OperatorSDKVersion is the version of the Quarkus extension of the java-operator-sdk
+	OperatorSDKVersion string
+
+	// Force overwrites an already existing gradle.properties instead of failing
+	Force bool
+}
+
+func (f *GradlePropertiesFile) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = "gradle.properties"
+	}
+
+	f.TemplateBody = gradlePropertiesTemplate
+
+	if f.Force {
+		f.IfExistsAction = machinery.OverwriteFile
+	} else {
+		f.IfExistsAction = machinery.Error
+	}
+
+	if f.QuarkusVersion == "" {
+		return errors.New("quarkus version is required in scaffold")
+	}
+	if f.OperatorSDKVersion == "" {
+		f.OperatorSDKVersion = DefaultOperatorSDKVersion
+	}
+
+	return nil
+}
+
+const gradlePropertiesTemplate = `quarkusPluginVersion={{ .QuarkusVersion }}
+quarkusVersion={{ .QuarkusVersion }}
+quarkusOperatorSdkVersion={{ .OperatorSDKVersion }}
+`
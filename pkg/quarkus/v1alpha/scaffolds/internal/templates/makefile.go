@@ -15,16 +15,27 @@
 package templates
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
+	"text/template"
 
 	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
 )
 
+const makefilePath = "Makefile"
+
+// BundleCRDsMarker marks where the CRD manifests of the APIs are added to the bundle. Makefiles use the same
+// comments as YAML files, which NewMarkerFor only tells apart by their extension.
+var BundleCRDsMarker = machinery.NewMarkerFor(makefilePath+".yaml", "bundle-crds")
+
 var _ machinery.Template = &Makefile{}
 
 // Makefile scaffolds the Makefile
 type Makefile struct {
 	machinery.TemplateMixin
+	machinery.DomainMixin
+	machinery.ProjectNameMixin
 
 	// Image is controller manager image name
 	Image string
@@ -32,6 +43,18 @@ type Makefile struct {
 	// Kustomize version to use in the project
 	KustomizeVersion string
 
+	// PackageCommand is the build tool command packaging the operator
+	PackageCommand string
+
+	// BuildDir is the directory the build tool writes its output to
+	BuildDir string
+
+	// OperatorVersion is the version of the operator, which the bundle is generated for
+	OperatorVersion string
+
+	// Force overwrites an already existing Makefile instead of failing
+	Force bool
+
 	// // AnsibleOperatorVersion is the version of the ansible-operator binary downloaded by the Makefile.
 	// AnsibleOperatorVersion string
 }
@@ -39,12 +62,16 @@ type Makefile struct {
 // SetTemplateDefaults implements machinery.Template
 func (f *Makefile) SetTemplateDefaults() error {
 	if f.Path == "" {
-		f.Path = "Makefile"
+		f.Path = makefilePath
 	}
 
-	f.TemplateBody = makefileTemplate
+	f.TemplateBody = makefileTemplate + makefileBundleTemplate
 
-	f.IfExistsAction = machinery.Error
+	if f.Force {
+		f.IfExistsAction = machinery.OverwriteFile
+	} else {
+		f.IfExistsAction = machinery.Error
+	}
 
 	if f.Image == "" {
 		f.Image = "controller:latest"
@@ -54,6 +81,18 @@ func (f *Makefile) SetTemplateDefaults() error {
 		return errors.New("kustomize version is required in scaffold")
 	}
 
+	if f.OperatorVersion == "" {
+		return errors.New("operator version is required in scaffold")
+	}
+
+	if f.PackageCommand == "" {
+		f.PackageCommand = "mvn package"
+	}
+
+	if f.BuildDir == "" {
+		f.BuildDir = "target"
+	}
+
 	// if f.AnsibleOperatorVersion == "" {
 	//     return errors.New("ansible-operator version is required in scaffold")
 	// }
@@ -61,6 +100,53 @@ func (f *Makefile) SetTemplateDefaults() error {
 	return nil
 }
 
+// BundleSection returns the bundle targets of the Makefile, which Makefiles scaffolded by previous versions lack
+func (f *Makefile) BundleSection() (string, error) {
+	if err := f.SetTemplateDefaults(); err != nil {
+		return "", err
+	}
+
+	tmpl, err := template.New("bundle").Parse(makefileBundleTemplate)
+	if err != nil {
+		return "", err
+	}
+	out := new(bytes.Buffer)
+	if err := tmpl.Execute(out, f); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+var _ machinery.Inserter = &MakefileBundleCRD{}
+
+// MakefileBundleCRD adds the CRD manifest of an API to the ones bundled by the Makefile
+type MakefileBundleCRD struct {
+	// CRDFile is the path of the CRD manifest generated for the API
+	CRDFile string
+}
+
+// GetPath implements machinery.Builder
+func (f *MakefileBundleCRD) GetPath() string {
+	return makefilePath
+}
+
+// GetIfExistsAction implements machinery.Builder
+func (f *MakefileBundleCRD) GetIfExistsAction() machinery.IfExistsAction {
+	return machinery.OverwriteFile
+}
+
+// GetMarkers implements machinery.Inserter
+func (f *MakefileBundleCRD) GetMarkers() []machinery.Marker {
+	return []machinery.Marker{BundleCRDsMarker}
+}
+
+// GetCodeFragments implements machinery.Inserter
+func (f *MakefileBundleCRD) GetCodeFragments() machinery.CodeFragmentsMap {
+	return machinery.CodeFragmentsMap{
+		BundleCRDsMarker: {fmt.Sprintf("BUNDLE_CRDS += %s\n", f.CRDFile)},
+	}
+}
+
 const makefileTemplate = `
 # Image URL to use all building/pushing image targets
 IMG ?= {{ .Image }}
@@ -86,22 +172,47 @@ help: ## Display this help.
 ##@ Build
 
 docker-build: ## Build docker image with the manager.
-	mvn package -Dquarkus.container-image.build=true -Dquarkus.container-image.image=${IMG}
+	{{ .PackageCommand }} -Dquarkus.container-image.build=true -Dquarkus.container-image.image=${IMG}
 
 docker-push: ## Push docker image with the manager.
-	mvn package -Dquarkus.container-image.push=true -Dquarkus.container-image.image=${IMG}
+	{{ .PackageCommand }} -Dquarkus.container-image.push=true -Dquarkus.container-image.image=${IMG}
 
 ##@ Deployment
 
 install: ## Install CRDs into the K8s cluster specified in ~/.kube/config.
-	@$(foreach file, $(wildcard target/kubernetes/*-v1.yml), kubectl apply -f $(file);)
+	@$(foreach file, $(wildcard {{ .BuildDir }}/kubernetes/*-v1.yml), kubectl apply -f $(file);)
 
 uninstall: ## Uninstall CRDs from the K8s cluster specified in ~/.kube/config.
-	@$(foreach file, $(wildcard target/kubernetes/*-v1.yml), kubectl delete -f $(file);)
+	@$(foreach file, $(wildcard {{ .BuildDir }}/kubernetes/*-v1.yml), kubectl delete -f $(file);)
 
 deploy: ## Deploy controller to the K8s cluster specified in ~/.kube/config.
-	kubectl apply -f target/kubernetes/kubernetes.yml
+	kubectl apply -f {{ .BuildDir }}/kubernetes/kubernetes.yml
 
 undeploy: ## Undeploy controller from the K8s cluster specified in ~/.kube/config.
-	kubectl delete -f target/kubernetes/kubernetes.yml
+	kubectl delete -f {{ .BuildDir }}/kubernetes/kubernetes.yml
+`
+
+const makefileBundleTemplate = `
+##@ Bundle
+
+VERSION ?= {{ .OperatorVersion }}
+IMAGE_TAG_BASE ?= {{ .Domain }}/{{ .ProjectName }}
+BUNDLE_IMG ?= $(IMAGE_TAG_BASE)-bundle:v$(VERSION)
+
+# The CRD manifests of the APIs, which are bundled along with the operator manifests
+BUNDLE_CRDS =
+#+kubebuilder:scaffold:bundle-crds
+
+.PHONY: bundle
+bundle: ## Generate bundle manifests and metadata, then validate generated files.
+	cat $(BUNDLE_CRDS) {{ .BuildDir }}/kubernetes/kubernetes.yml | operator-sdk generate bundle -q --overwrite --version $(VERSION) --default-channel=stable --channels=stable --package={{ .ProjectName }}
+	operator-sdk bundle validate ./bundle
+
+.PHONY: bundle-build
+bundle-build: ## Build the bundle image.
+	docker build -f bundle.Dockerfile -t $(BUNDLE_IMG) .
+
+.PHONY: bundle-push
+bundle-push: ## Push the bundle image.
+	docker push $(BUNDLE_IMG)
 `
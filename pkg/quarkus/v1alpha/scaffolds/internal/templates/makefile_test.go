@@ -0,0 +1,39 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package templates
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+var _ = Describe("Makefile", func() {
+	It("generates the bundle of the version of the operator", func() {
+		section, err := (&Makefile{
+			ProjectNameMixin: machinery.ProjectNameMixin{ProjectName: "memcached-operator"},
+			KustomizeVersion: "v3.5.4",
+			OperatorVersion:  "1.2.0",
+		}).BundleSection()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(section).To(ContainSubstring("VERSION ?= 1.2.0\n"))
+		Expect(section).To(ContainSubstring("operator-sdk generate bundle -q --overwrite --version $(VERSION) "))
+	})
+
+	It("requires the version of the operator", func() {
+		Expect((&Makefile{KustomizeVersion: "v3.5.4"}).SetTemplateDefaults()).To(MatchError(ContainSubstring(
+			"operator version is required")))
+	})
+})
@@ -18,7 +18,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/scaffolds/internal/templates/util"
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/templates/util"
 	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
 )
 
@@ -32,6 +32,15 @@ type OperatorFile struct {
 
 	// Name of the operator used for the main file.
 	OperatorName string
+
+	// EENamespace is the package of the enterprise Java APIs, javax by default or jakarta as of Quarkus 3
+	EENamespace string
+
+	// Kotlin indicates that the main class is written in Kotlin instead of Java
+	Kotlin bool
+
+	// Force overwrites an already existing main class instead of failing
+	Force bool
 }
 
 func (f *OperatorFile) SetTemplateDefaults() error {
@@ -43,11 +52,27 @@ func (f *OperatorFile) SetTemplateDefaults() error {
 		f.OperatorName = strings.TrimSuffix(f.OperatorName, "Operator")
 	}
 
-	if f.Path == "" {
-		f.Path = util.PrependJavaPath(f.OperatorName+"Operator.java", util.AsPath(f.Package))
+	if f.Kotlin {
+		if f.Path == "" {
+			f.Path = util.PrependKotlinPath(f.OperatorName+"Operator.kt", util.AsPath(f.Package))
+		}
+		f.TemplateBody = operatorKotlinTemplate
+	} else {
+		if f.Path == "" {
+			f.Path = util.PrependJavaPath(f.OperatorName+"Operator.java", util.AsPath(f.Package))
+		}
+		f.TemplateBody = operatorTemplate
+	}
+
+	if f.Force {
+		f.IfExistsAction = machinery.OverwriteFile
+	} else {
+		f.IfExistsAction = machinery.Error
 	}
 
-	f.TemplateBody = operatorTemplate
+	if f.EENamespace == "" {
+		f.EENamespace = "javax"
+	}
 
 	return nil
 }
@@ -60,7 +85,7 @@ import io.javaoperatorsdk.operator.Operator;
 import io.quarkus.runtime.Quarkus;
 import io.quarkus.runtime.QuarkusApplication;
 import io.quarkus.runtime.annotations.QuarkusMain;
-import javax.inject.Inject;
+import {{ .EENamespace }}.inject.Inject;
 
 @QuarkusMain
 public class {{ .OperatorName }}Operator implements QuarkusApplication {
@@ -80,3 +105,33 @@ public class {{ .OperatorName }}Operator implements QuarkusApplication {
   }
 }
 `
+
+const operatorKotlinTemplate = `package {{ .Package }}
+
+import io.javaoperatorsdk.operator.Operator
+import io.quarkus.runtime.Quarkus
+import io.quarkus.runtime.QuarkusApplication
+import io.quarkus.runtime.annotations.QuarkusMain
+import {{ .EENamespace }}.inject.Inject
+
+@QuarkusMain
+class {{ .OperatorName }}Operator : QuarkusApplication {
+
+    @Inject
+    lateinit var operator: Operator
+
+    override fun run(vararg args: String): Int {
+        operator.start()
+
+        Quarkus.waitForExit()
+        return 0
+    }
+
+    companion object {
+        @JvmStatic
+        fun main(vararg args: String) {
+            Quarkus.run({{ .OperatorName }}Operator::class.java, *args)
+        }
+    }
+}
+`
@@ -42,6 +42,45 @@ var _ = Describe("operatorfile", func() {
 			err = tmpl.Execute(buf, of)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(buf.String()).To(ContainSubstring("public class MemcachedQuarkusOperator "))
+			Expect(buf.String()).To(ContainSubstring("import javax.inject.Inject;"))
+		})
+
+		It("Should import the jakarta packages when asked to", func() {
+			of := OperatorFile{
+				OperatorName: "Memcached",
+				EENamespace:  "jakarta",
+			}
+
+			err := of.SetTemplateDefaults()
+			Expect(err).ToNot(HaveOccurred())
+
+			tmpl, err := template.New("operatorfile").Parse(of.TemplateBody)
+			Expect(err).ToNot(HaveOccurred())
+			buf := new(bytes.Buffer)
+			err = tmpl.Execute(buf, of)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buf.String()).To(ContainSubstring("import jakarta.inject.Inject;"))
+		})
+
+		It("Should write the main class in Kotlin when asked to", func() {
+			of := OperatorFile{
+				Package:      "com.example",
+				OperatorName: "Memcached",
+				Kotlin:       true,
+			}
+
+			err := of.SetTemplateDefaults()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(of.Path).To(Equal("src/main/kotlin/com/example/MemcachedOperator.kt"))
+
+			tmpl, err := template.New("operatorfile").Parse(of.TemplateBody)
+			Expect(err).ToNot(HaveOccurred())
+			buf := new(bytes.Buffer)
+			err = tmpl.Execute(buf, of)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buf.String()).To(ContainSubstring("class MemcachedOperator : QuarkusApplication {"))
+			Expect(buf.String()).To(ContainSubstring("Quarkus.run(MemcachedOperator::class.java, *args)"))
+			Expect(buf.String()).NotTo(ContainSubstring(";"))
 		})
 	})
 })
@@ -15,9 +15,19 @@
 package templates
 
 import (
+	"errors"
+
 	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
 )
 
+const (
+	// DefaultOperatorSDKVersion is the version of the Quarkus extension of the java-operator-sdk used when none is set
+	DefaultOperatorSDKVersion = "3.0.7"
+
+	// DefaultJavaVersion is the Java release the sources are compiled for when none is set
+	DefaultJavaVersion = "11"
+)
+
 var _ machinery.Template = &PomXmlFile{}
 
 type PomXmlFile struct {
@@ -27,6 +37,32 @@ type PomXmlFile struct {
 	Package         string
 	ProjectName     string
 	OperatorVersion string
+
+	// GroupID and ArtifactID are the Maven coordinates of the project, the package and the project name by default
+	GroupID    string
+	ArtifactID string
+
+	// QuarkusVersion is the version of Quarkus used to build the project
+	QuarkusVersion string
+
+	// OperatorSDKVersion is the version of the Quarkus extension of the java-operator-sdk
+	OperatorSDKVersion string
+
+	// JavaVersion is the Java release the sources are compiled for
+	JavaVersion string
+
+	// Kotlin indicates that the project compiles Kotlin sources along with the Java ones
+	Kotlin bool
+
+	// EENamespace is the package of the enterprise Java APIs, javax by default or jakarta as of Quarkus 3
+	EENamespace string
+
+	// BundleGenerator is the artifact generating the OLM bundle, the csv generator by default or the bundle
+	// generator as of quarkus-operator-sdk 4
+	BundleGenerator string
+
+	// Force overwrites an already existing pom.xml instead of failing
+	Force bool
 }
 
 func (f *PomXmlFile) SetTemplateDefaults() error {
@@ -36,6 +72,34 @@ func (f *PomXmlFile) SetTemplateDefaults() error {
 
 	f.TemplateBody = pomxmlTemplate
 
+	if f.Force {
+		f.IfExistsAction = machinery.OverwriteFile
+	} else {
+		f.IfExistsAction = machinery.Error
+	}
+
+	if f.QuarkusVersion == "" {
+		return errors.New("quarkus version is required in scaffold")
+	}
+	if f.OperatorSDKVersion == "" {
+		f.OperatorSDKVersion = DefaultOperatorSDKVersion
+	}
+	if f.GroupID == "" {
+		f.GroupID = f.Package
+	}
+	if f.ArtifactID == "" {
+		f.ArtifactID = f.ProjectName
+	}
+	if f.JavaVersion == "" {
+		f.JavaVersion = DefaultJavaVersion
+	}
+	if f.EENamespace == "" {
+		f.EENamespace = "javax"
+	}
+	if f.BundleGenerator == "" {
+		f.BundleGenerator = "quarkus-operator-sdk-csv-generator"
+	}
+
 	return nil
 }
 
@@ -45,20 +109,23 @@ const pomxmlTemplate = `<?xml version="1.0" encoding="UTF-8"?>
   xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"
   xsi:schemaLocation="http://maven.apache.org/POM/4.0.0 http://maven.apache.org/xsd/maven-4.0.0.xsd">
   <modelVersion>4.0.0</modelVersion>
-  <groupId>{{ .Package }}</groupId>
-  <artifactId>{{ .ProjectName }}</artifactId>
+  <groupId>{{ .GroupID }}</groupId>
+  <artifactId>{{ .ArtifactID }}</artifactId>
   <name>{{ .ProjectName }}</name>
   <version>{{ .OperatorVersion }}-SNAPSHOT</version>
   <packaging>jar</packaging>
   <properties>
     <compiler-plugin.version>3.8.1</compiler-plugin.version>
     <maven.compiler.parameters>true</maven.compiler.parameters>
-    <maven.compiler.source>11</maven.compiler.source>
-    <maven.compiler.target>11</maven.compiler.target>
+    <maven.compiler.source>{{ .JavaVersion }}</maven.compiler.source>
+    <maven.compiler.target>{{ .JavaVersion }}</maven.compiler.target>
     <project.build.sourceEncoding>UTF-8</project.build.sourceEncoding>
     <project.reporting.outputEncoding>UTF-8</project.reporting.outputEncoding>
-    <quarkus-sdk.version>3.0.7</quarkus-sdk.version>
-    <quarkus.version>2.7.5.Final</quarkus.version>
+    <quarkus-sdk.version>{{ .OperatorSDKVersion }}</quarkus-sdk.version>
+    <quarkus.version>{{ .QuarkusVersion }}</quarkus.version>
+{{- if .Kotlin }}
+    <kotlin.version>1.6.21</kotlin.version>
+{{- end }}
   </properties>
 
   <dependencyManagement>
@@ -79,13 +146,25 @@ const pomxmlTemplate = `<?xml version="1.0" encoding="UTF-8"?>
     </dependency>
     <dependency>
       <groupId>io.quarkiverse.operatorsdk</groupId>
-      <artifactId>quarkus-operator-sdk-csv-generator</artifactId>
+      <artifactId>{{ .BundleGenerator }}</artifactId>
     </dependency>
     <dependency>
       <groupId>io.quarkus</groupId>
       <artifactId>quarkus-micrometer-registry-prometheus</artifactId>
       <version>${quarkus.version}</version>
     </dependency>
+{{- if .Kotlin }}
+    <dependency>
+      <groupId>io.quarkus</groupId>
+      <artifactId>quarkus-kotlin</artifactId>
+      <version>${quarkus.version}</version>
+    </dependency>
+    <dependency>
+      <groupId>org.jetbrains.kotlin</groupId>
+      <artifactId>kotlin-stdlib-jdk8</artifactId>
+      <version>${kotlin.version}</version>
+    </dependency>
+{{- end }}
   </dependencies>
 
   <build>
@@ -102,9 +181,63 @@ const pomxmlTemplate = `<?xml version="1.0" encoding="UTF-8"?>
           </execution>
         </executions>
     </plugin>
+{{- if .Kotlin }}
+    <plugin>
+      <groupId>org.jetbrains.kotlin</groupId>
+      <artifactId>kotlin-maven-plugin</artifactId>
+      <version>${kotlin.version}</version>
+      <executions>
+        <execution>
+          <id>compile</id>
+          <goals>
+            <goal>compile</goal>
+          </goals>
+          <configuration>
+            <sourceDirs>
+              <sourceDir>${project.basedir}/src/main/kotlin</sourceDir>
+              <sourceDir>${project.basedir}/src/main/java</sourceDir>
+            </sourceDirs>
+          </configuration>
+        </execution>
+      </executions>
+      <configuration>
+        <javaParameters>true</javaParameters>
+        <jvmTarget>{{ .JavaVersion }}</jvmTarget>
+        <compilerPlugins>
+          <plugin>all-open</plugin>
+        </compilerPlugins>
+        <pluginOptions>
+          <option>all-open:annotation={{ .EENamespace }}.enterprise.context.ApplicationScoped</option>
+        </pluginOptions>
+      </configuration>
+      <dependencies>
+        <dependency>
+          <groupId>org.jetbrains.kotlin</groupId>
+          <artifactId>kotlin-maven-allopen</artifactId>
+          <version>${kotlin.version}</version>
+        </dependency>
+      </dependencies>
+    </plugin>
+{{- end }}
     <plugin>
       <artifactId>maven-compiler-plugin</artifactId>
       <version>${compiler-plugin.version}</version>
+{{- if .Kotlin }}
+      <executions>
+        <!-- Compile the Java sources after the Kotlin ones they may depend on -->
+        <execution>
+          <id>default-compile</id>
+          <phase>none</phase>
+        </execution>
+        <execution>
+          <id>java-compile</id>
+          <phase>compile</phase>
+          <goals>
+            <goal>compile</goal>
+          </goals>
+        </execution>
+      </executions>
+{{- end }}
     </plugin>
     </plugins>
   </build>
@@ -0,0 +1,58 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package templates
+
+import (
+	"bytes"
+	"text/template"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("pomxml", func() {
+	render := func(f *PomXmlFile) string {
+		Expect(f.SetTemplateDefaults()).To(Succeed())
+		tmpl, err := template.New("pomxml").Parse(f.TemplateBody)
+		Expect(err).NotTo(HaveOccurred())
+		buf := new(bytes.Buffer)
+		Expect(tmpl.Execute(buf, f)).To(Succeed())
+		return buf.String()
+	}
+
+	It("defaults the coordinates to the package and the project name", func() {
+		pom := render(&PomXmlFile{Package: "com.example", ProjectName: "memcached-operator", QuarkusVersion: "2.7.5.Final"})
+		Expect(pom).To(ContainSubstring("<groupId>com.example</groupId>\n  <artifactId>memcached-operator</artifactId>"))
+		Expect(pom).To(ContainSubstring("<maven.compiler.source>11</maven.compiler.source>"))
+		Expect(pom).To(ContainSubstring("<artifactId>quarkus-operator-sdk-csv-generator</artifactId>"))
+	})
+
+	It("uses the given coordinates and versions", func() {
+		pom := render(&PomXmlFile{
+			Package:            "com.acme.platform.operators.memcached",
+			ProjectName:        "memcached-operator",
+			GroupID:            "com.acme.platform",
+			ArtifactID:         "memcached",
+			QuarkusVersion:     "2.7.5.Final",
+			OperatorSDKVersion: "3.0.8",
+			JavaVersion:        "17",
+			BundleGenerator:    "quarkus-operator-sdk-bundle-generator",
+		})
+		Expect(pom).To(ContainSubstring("<groupId>com.acme.platform</groupId>\n  <artifactId>memcached</artifactId>"))
+		Expect(pom).To(ContainSubstring("<quarkus-sdk.version>3.0.8</quarkus-sdk.version>"))
+		Expect(pom).To(ContainSubstring("<maven.compiler.source>17</maven.compiler.source>"))
+		Expect(pom).To(ContainSubstring("<artifactId>quarkus-operator-sdk-bundle-generator</artifactId>"))
+	})
+})
@@ -0,0 +1,62 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package templates
+
+import (
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+var _ machinery.Template = &SettingsGradleFile{}
+
+// SettingsGradleFile scaffolds the Gradle settings script
+type SettingsGradleFile struct {
+	machinery.TemplateMixin
+
+	ProjectName string
+
+	// Force overwrites an already existing settings.gradle.kts instead of failing
+	Force bool
+}
+
+func (f *SettingsGradleFile) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = "settings.gradle.kts"
+	}
+
+	f.TemplateBody = settingsGradleTemplate
+
+	if f.Force {
+		f.IfExistsAction = machinery.OverwriteFile
+	} else {
+		f.IfExistsAction = machinery.Error
+	}
+
+	return nil
+}
+
+const settingsGradleTemplate = `pluginManagement {
+    val quarkusPluginVersion: String by settings
+    repositories {
+        mavenCentral()
+        gradlePluginPortal()
+        mavenLocal()
+    }
+    plugins {
+        id("io.quarkus") version quarkusPluginVersion
+    }
+}
+
+rootProject.name = "{{ .ProjectName }}"
+`
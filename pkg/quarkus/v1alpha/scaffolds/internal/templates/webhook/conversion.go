@@ -0,0 +1,113 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/templates/util"
+)
+
+var _ machinery.Template = &Conversion{}
+
+// Conversion scaffolds the mapper used by the conversion webhook
+type Conversion struct {
+	machinery.TemplateMixin
+	machinery.ResourceMixin
+
+	// Package is the source files package
+	Package string
+
+	// HubPackage is the package of the custom resource class of the hub version, i.e. the storage version
+	HubPackage string
+
+	// Name of the custom resource class
+	ClassName string
+
+	// Force overwrites an already existing mapper
+	Force bool
+}
+
+func (f *Conversion) SetTemplateDefaults() error {
+	if f.ClassName == "" {
+		return fmt.Errorf("invalid mapper name")
+	}
+
+	if f.Path == "" {
+		f.Path = util.PrependJavaPath(f.ClassName+"Mapper.java", util.AsPath(f.Package))
+	}
+
+	if f.HubPackage == "" {
+		f.HubPackage = f.Package
+	}
+
+	f.TemplateBody = conversionTemplate
+
+	if f.Force {
+		f.IfExistsAction = machinery.OverwriteFile
+	} else {
+		f.IfExistsAction = machinery.SkipFile
+	}
+
+	return nil
+}
+
+// Hub returns the name of the custom resource class of the hub version, qualified if it is in another package
+func (f *Conversion) Hub() string {
+	if f.HubPackage == f.Package {
+		return f.ClassName
+	}
+	return f.HubPackage + "." + f.ClassName
+}
+
+const conversionTemplate = `package {{ .Package }};
+
+import io.javaoperatorsdk.webhook.conversion.Mapper;
+import io.javaoperatorsdk.webhook.conversion.TargetVersion;
+
+@TargetVersion("{{ .Resource.Version }}")
+public class {{ .ClassName }}Mapper implements Mapper<{{ .ClassName }}, {{ .Hub }}> {
+
+  @Override
+  public {{ .Hub }} toHub({{ .ClassName }} resource) {
+    // TODO: convert the resource to the hub version
+{{- if eq .Package .HubPackage }}
+
+    return resource;
+{{- else }}
+
+    {{ .Hub }} hub = new {{ .Hub }}();
+    hub.setMetadata(resource.getMetadata());
+    return hub;
+{{- end }}
+  }
+
+  @Override
+  public {{ .ClassName }} fromHub({{ .Hub }} hub) {
+    // TODO: convert the hub version back to the resource
+{{- if eq .Package .HubPackage }}
+
+    return hub;
+{{- else }}
+
+    {{ .ClassName }} resource = new {{ .ClassName }}();
+    resource.setMetadata(hub.getMetadata());
+    return resource;
+{{- end }}
+  }
+}
+`
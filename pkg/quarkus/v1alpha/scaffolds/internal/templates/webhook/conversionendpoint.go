@@ -0,0 +1,150 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/templates/util"
+)
+
+const mappersMarker = "mappers"
+
+var (
+	_ machinery.Template = &ConversionEndpoint{}
+	_ machinery.Inserter = &ConversionEndpointUpdater{}
+)
+
+// conversionEndpointPath returns the path of the endpoint of the conversion webhook of a kind
+func conversionEndpointPath(pkg, className string) string {
+	return util.PrependJavaPath(className+"ConversionEndpoint.java", util.AsPath(pkg))
+}
+
+// ConversionEndpoint scaffolds the REST resource serving the conversion webhook of a kind, which converts between
+// all of its versions
+type ConversionEndpoint struct {
+	machinery.TemplateMixin
+	machinery.ResourceMixin
+
+	// Package is the package of the kind, which holds the packages of its versions
+	Package string
+
+	// Name of the custom resource class
+	ClassName string
+
+	// EENamespace is the package of the enterprise Java APIs, javax by default or jakarta as of Quarkus 3
+	EENamespace string
+
+	// MappersMarker is where create webhook registers the mappers of the versions converted by the webhook
+	MappersMarker machinery.Marker
+
+	// QualifiedGroupWithDash is the resource group with dots replaced by dashes, used in the webhook path
+	QualifiedGroupWithDash string
+}
+
+func (f *ConversionEndpoint) SetTemplateDefaults() error {
+	if f.ClassName == "" {
+		return fmt.Errorf("invalid endpoint name")
+	}
+
+	if f.Path == "" {
+		f.Path = conversionEndpointPath(f.Package, f.ClassName)
+	}
+
+	f.TemplateBody = conversionEndpointTemplate
+
+	if f.EENamespace == "" {
+		f.EENamespace = "javax"
+	}
+
+	// The endpoint is generated once, then the mappers of the versions are registered as their conversion
+	// webhooks are scaffolded
+	f.IfExistsAction = machinery.SkipFile
+
+	f.MappersMarker = util.NewJavaMarker(mappersMarker)
+
+	f.QualifiedGroupWithDash = strings.Replace(f.Resource.QualifiedGroup(), ".", "-", -1)
+
+	return nil
+}
+
+const conversionEndpointTemplate = `package {{ .Package }};
+
+import io.fabric8.kubernetes.api.model.apiextensions.v1.ConversionReview;
+import io.javaoperatorsdk.webhook.conversion.ConversionController;
+
+import {{ .EENamespace }}.ws.rs.Consumes;
+import {{ .EENamespace }}.ws.rs.POST;
+import {{ .EENamespace }}.ws.rs.Path;
+import {{ .EENamespace }}.ws.rs.Produces;
+import {{ .EENamespace }}.ws.rs.core.MediaType;
+
+@Path("/")
+public class {{ .ClassName }}ConversionEndpoint {
+
+  private final ConversionController conversionController = new ConversionController();
+
+  public {{ .ClassName }}ConversionEndpoint() {
+    {{ .MappersMarker }}
+  }
+
+  @POST
+  @Path("convert-{{ .QualifiedGroupWithDash }}-{{ lower .Resource.Kind }}")
+  @Consumes(MediaType.APPLICATION_JSON)
+  @Produces(MediaType.APPLICATION_JSON)
+  public ConversionReview convert(ConversionReview conversionReview) {
+    return conversionController.handle(conversionReview);
+  }
+}
+`
+
+// ConversionEndpointUpdater registers the mapper of a version with the endpoint of the conversion webhook of its kind
+type ConversionEndpointUpdater struct {
+	machinery.InserterMixin
+
+	// Package is the package of the kind, which holds the packages of its versions
+	Package string
+
+	// Name of the custom resource class
+	ClassName string
+
+	// Mapper is the qualified name of the mapper of the version
+	Mapper string
+}
+
+// GetPath implements machinery.Builder
+func (f *ConversionEndpointUpdater) GetPath() string {
+	return conversionEndpointPath(f.Package, f.ClassName)
+}
+
+// GetMarkers implements machinery.Inserter
+func (f *ConversionEndpointUpdater) GetMarkers() []machinery.Marker {
+	return []machinery.Marker{util.NewJavaMarker(mappersMarker)}
+}
+
+const registerMapperCodeFragment = `    conversionController.registerMapper(new %s());
+`
+
+// GetCodeFragments implements machinery.Inserter
+func (f *ConversionEndpointUpdater) GetCodeFragments() machinery.CodeFragmentsMap {
+	return machinery.CodeFragmentsMap{
+		util.NewJavaMarker(mappersMarker): {
+			fmt.Sprintf(registerMapperCodeFragment, f.Mapper),
+		},
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/templates/util"
+)
+
+var _ machinery.Template = &Defaulter{}
+
+// Defaulter scaffolds the mutator used by the defaulting webhook
+type Defaulter struct {
+	machinery.TemplateMixin
+
+	// Package is the source files package
+	Package string
+
+	// Name of the custom resource class
+	ClassName string
+
+	// Force overwrites an already existing mutator
+	Force bool
+}
+
+func (f *Defaulter) SetTemplateDefaults() error {
+	if f.ClassName == "" {
+		return fmt.Errorf("invalid defaulter name")
+	}
+
+	if f.Path == "" {
+		f.Path = util.PrependJavaPath(f.ClassName+"Defaulter.java", util.AsPath(f.Package))
+	}
+
+	f.TemplateBody = defaulterTemplate
+
+	if f.Force {
+		f.IfExistsAction = machinery.OverwriteFile
+	} else {
+		f.IfExistsAction = machinery.SkipFile
+	}
+
+	return nil
+}
+
+const defaulterTemplate = `package {{ .Package }};
+
+import io.javaoperatorsdk.webhook.admission.NotAllowedException;
+import io.javaoperatorsdk.webhook.admission.Operation;
+import io.javaoperatorsdk.webhook.admission.mutation.Mutator;
+
+public class {{ .ClassName }}Defaulter implements Mutator<{{ .ClassName }}> {
+
+  @Override
+  public {{ .ClassName }} mutate({{ .ClassName }} resource, Operation operation) throws NotAllowedException {
+    // TODO: fill in the defaulting logic
+
+    return resource;
+  }
+}
+`
@@ -0,0 +1,114 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/templates/util"
+)
+
+var _ machinery.Template = &Endpoint{}
+
+// Endpoint scaffolds the REST resource serving the admission webhooks of a version of a custom resource
+type Endpoint struct {
+	machinery.TemplateMixin
+	machinery.ResourceMixin
+
+	// Package is the source files package
+	Package string
+
+	// Name of the custom resource class
+	ClassName string
+
+	// EENamespace is the package of the enterprise Java APIs, javax by default or jakarta as of Quarkus 3
+	EENamespace string
+
+	// QualifiedGroupWithDash is the resource group with dots replaced by dashes, used in the webhook paths
+	QualifiedGroupWithDash string
+}
+
+func (f *Endpoint) SetTemplateDefaults() error {
+	if f.ClassName == "" {
+		return fmt.Errorf("invalid endpoint name")
+	}
+
+	if f.Path == "" {
+		f.Path = util.PrependJavaPath(f.ClassName+"WebhookEndpoint.java", util.AsPath(f.Package))
+	}
+
+	f.TemplateBody = endpointTemplate
+
+	if f.EENamespace == "" {
+		f.EENamespace = "javax"
+	}
+
+	// The endpoint only wires the webhooks together, so it always reflects every webhook of the resource.
+	f.IfExistsAction = machinery.OverwriteFile
+
+	f.QualifiedGroupWithDash = strings.Replace(f.Resource.QualifiedGroup(), ".", "-", -1)
+
+	return nil
+}
+
+const endpointTemplate = `package {{ .Package }};
+
+
+import io.fabric8.kubernetes.api.model.admission.v1.AdmissionReview;
+import io.javaoperatorsdk.webhook.admission.AdmissionController;
+
+import {{ .EENamespace }}.ws.rs.Consumes;
+import {{ .EENamespace }}.ws.rs.POST;
+import {{ .EENamespace }}.ws.rs.Path;
+import {{ .EENamespace }}.ws.rs.Produces;
+import {{ .EENamespace }}.ws.rs.core.MediaType;
+
+@Path("/")
+public class {{ .ClassName }}WebhookEndpoint {
+{{- if .Resource.HasDefaultingWebhook }}
+
+  private final AdmissionController<{{ .ClassName }}> defaultingController =
+      new AdmissionController<>(new {{ .ClassName }}Defaulter());
+{{- end }}
+{{- if .Resource.HasValidationWebhook }}
+
+  private final AdmissionController<{{ .ClassName }}> validationController =
+      new AdmissionController<>(new {{ .ClassName }}Validator());
+{{- end }}
+{{- if .Resource.HasDefaultingWebhook }}
+
+  @POST
+  @Path("mutate-{{ .QualifiedGroupWithDash }}-{{ .Resource.Version }}-{{ lower .Resource.Kind }}")
+  @Consumes(MediaType.APPLICATION_JSON)
+  @Produces(MediaType.APPLICATION_JSON)
+  public AdmissionReview mutate(AdmissionReview admissionReview) {
+    return defaultingController.handle(admissionReview);
+  }
+{{- end }}
+{{- if .Resource.HasValidationWebhook }}
+
+  @POST
+  @Path("validate-{{ .QualifiedGroupWithDash }}-{{ .Resource.Version }}-{{ lower .Resource.Kind }}")
+  @Consumes(MediaType.APPLICATION_JSON)
+  @Produces(MediaType.APPLICATION_JSON)
+  public AdmissionReview validate(AdmissionReview admissionReview) {
+    return validationController.handle(admissionReview);
+  }
+{{- end }}
+}
+`
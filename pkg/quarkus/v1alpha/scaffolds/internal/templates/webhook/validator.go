@@ -0,0 +1,74 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/templates/util"
+)
+
+var _ machinery.Template = &Validator{}
+
+// Validator scaffolds the validator used by the validating webhook
+type Validator struct {
+	machinery.TemplateMixin
+
+	// Package is the source files package
+	Package string
+
+	// Name of the custom resource class
+	ClassName string
+
+	// Force overwrites an already existing validator
+	Force bool
+}
+
+func (f *Validator) SetTemplateDefaults() error {
+	if f.ClassName == "" {
+		return fmt.Errorf("invalid validator name")
+	}
+
+	if f.Path == "" {
+		f.Path = util.PrependJavaPath(f.ClassName+"Validator.java", util.AsPath(f.Package))
+	}
+
+	f.TemplateBody = validatorTemplate
+
+	if f.Force {
+		f.IfExistsAction = machinery.OverwriteFile
+	} else {
+		f.IfExistsAction = machinery.SkipFile
+	}
+
+	return nil
+}
+
+const validatorTemplate = `package {{ .Package }};
+
+import io.javaoperatorsdk.webhook.admission.NotAllowedException;
+import io.javaoperatorsdk.webhook.admission.Operation;
+import io.javaoperatorsdk.webhook.admission.validation.Validator;
+
+public class {{ .ClassName }}Validator implements Validator<{{ .ClassName }}> {
+
+  @Override
+  public void validate({{ .ClassName }} resource, Operation operation) throws NotAllowedException {
+    // TODO: fill in the validation logic, throw a NotAllowedException to reject the request
+  }
+}
+`
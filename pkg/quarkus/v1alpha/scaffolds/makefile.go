@@ -0,0 +1,120 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffolds
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/afero"
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v3/pkg/model/resource"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/scaffolds/internal/templates"
+)
+
+// legacyBundleMarker preceded the recipe listing the CRD manifests in the Makefiles scaffolded by previous versions
+const legacyBundleMarker = "## marker"
+
+// crdFile returns the path of the CRD manifest of the resource. The CRD generator writes every version
+// of a kind to the same file, named after the CRD API version.
+func (c PluginConfig) crdFile(res resource.Resource) string {
+	return fmt.Sprintf("%s/kubernetes/%s.%s-%s.yml", c.BuildDir(), res.Plural, res.QualifiedGroup(), res.API.CRDVersion)
+}
+
+// bundleCRD returns the builder adding the CRD manifest of the resource to the bundle, or nil if the
+// Makefile already bundles it or cannot be edited, along with notes on the edits of the Makefile which are worth
+// reporting
+func bundleCRD(fs machinery.Filesystem, cfg config.Config, pluginConfig PluginConfig, res resource.Resource) (machinery.Builder, []string, error) {
+	var notes []string
+	note, err := prepareMakefile(fs, cfg, pluginConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	if note != "" {
+		notes = append(notes, note)
+	}
+
+	makefile, err := afero.ReadFile(fs.FS, makefileFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading %s: %w", makefileFile, err)
+	}
+	// The versions of a kind share their CRD manifest, and Makefiles migrated from previous versions may list it
+	// in the bundle recipe
+	crdFile := pluginConfig.crdFile(res)
+	if strings.Contains(string(makefile), " "+crdFile) {
+		return nil, notes, nil
+	}
+	if marker := templates.BundleCRDsMarker.String(); !strings.Contains(string(makefile), marker) {
+		return nil, append(notes, fmt.Sprintf("skipped adding %s to the bundle, as the Makefile lacks the %q marker",
+			crdFile, marker)), nil
+	}
+
+	return &templates.MakefileBundleCRD{CRDFile: crdFile}, notes, nil
+}
+
+// prepareMakefile adds the marker the CRD manifests are inserted at to the Makefiles scaffolded by previous
+// versions, which either lack the bundle targets or list the CRD manifests in the bundle recipe, and returns a
+// note telling how the Makefile was migrated, if it was. Makefiles with a bundle target of their own are left
+// untouched.
+func prepareMakefile(fs machinery.Filesystem, cfg config.Config, pluginConfig PluginConfig) (string, error) {
+	marker := templates.BundleCRDsMarker.String()
+
+	note := ""
+	err := updateFile(fs, makefileFile, func(makefile string) (string, error) {
+		if strings.Contains(makefile, marker) {
+			return makefile, nil
+		}
+
+		lines := strings.Split(makefile, "\n")
+		for i, line := range lines {
+			if strings.TrimSpace(line) != legacyBundleMarker {
+				continue
+			}
+			if i+1 == len(lines) || !strings.HasPrefix(lines[i+1], "\tcat ") {
+				return "", fmt.Errorf("unable to find the bundle recipe following %q", legacyBundleMarker)
+			}
+
+			// Keep the manifests listed in the recipe and bundle the ones of the new APIs along
+			lines[i+1] = "\tcat $(BUNDLE_CRDS) " + strings.TrimPrefix(lines[i+1], "\tcat ")
+			lines = append(lines[:i], lines[i+1:]...)
+			note = "migrated the bundle recipe to bundle the CRD manifests listed in BUNDLE_CRDS"
+			return strings.TrimRight(strings.Join(lines, "\n"), "\n") +
+				"\n\n# The CRD manifests of the APIs, which are bundled along with the ones listed in the bundle recipe\n" +
+				"BUNDLE_CRDS =\n" + marker + "\n", nil
+		}
+
+		for _, line := range lines {
+			if strings.HasPrefix(line, "bundle:") {
+				return makefile, nil
+			}
+		}
+
+		section, err := (&templates.Makefile{
+			DomainMixin:      machinery.DomainMixin{Domain: cfg.GetDomain()},
+			ProjectNameMixin: machinery.ProjectNameMixin{ProjectName: cfg.GetProjectName()},
+			KustomizeVersion: kustomizeVersion,
+			BuildDir:         pluginConfig.BuildDir(),
+			OperatorVersion:  pluginConfig.ProjectVersion(),
+		}).BundleSection()
+		if err != nil {
+			return "", err
+		}
+		note = "added the bundle targets, which the Makefile lacked"
+		return strings.TrimRight(makefile, "\n") + "\n" + section, nil
+	})
+	return note, err
+}
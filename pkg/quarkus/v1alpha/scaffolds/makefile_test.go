@@ -0,0 +1,122 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffolds
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	v3 "sigs.k8s.io/kubebuilder/v3/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v3/pkg/model/resource"
+)
+
+const legacyMakefile = `
+docker-build: ## Build docker image with the manager.
+	mvn package -Dquarkus.container-image.build=true -Dquarkus.container-image.image=${IMG}
+
+##@Bundle
+.PHONY: bundle
+bundle:  ## Generate bundle manifests and metadata, then validate generated files.
+## marker
+	cat target/kubernetes/memcacheds.cache.example.com-v1.yml target/kubernetes/kubernetes.yml | operator-sdk generate bundle -q --overwrite --version 0.1.1 --default-channel=stable --channels=stable --package=memcached-operator
+	operator-sdk bundle validate ./bundle
+`
+
+var _ = Describe("Makefile", func() {
+	var (
+		fs  machinery.Filesystem
+		cfg config.Config
+		res resource.Resource
+
+		// notes are the notes of the last edit of the Makefile
+		notes []string
+	)
+
+	readMakefile := func() string {
+		contents, err := afero.ReadFile(fs.FS, makefileFile)
+		Expect(err).NotTo(HaveOccurred())
+		return string(contents)
+	}
+
+	addBundleCRD := func() error {
+		builder, edits, err := bundleCRD(fs, cfg, PluginConfig{}, res)
+		notes = edits
+		if err != nil || builder == nil {
+			return err
+		}
+		return machinery.NewScaffold(fs).Execute(builder)
+	}
+
+	BeforeEach(func() {
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		cfg = v3.New()
+		Expect(cfg.SetDomain("example.com")).To(Succeed())
+		Expect(cfg.SetProjectName("memcached-operator")).To(Succeed())
+		res = resource.Resource{
+			GVK:    resource.GVK{Group: "cache", Domain: "example.com", Version: "v1", Kind: "Memcached"},
+			Plural: "memcacheds",
+			API:    &resource.API{CRDVersion: "v1"},
+		}
+	})
+
+	It("adds the bundle targets to Makefiles lacking them", func() {
+		Expect(afero.WriteFile(fs.FS, makefileFile, []byte("all: docker-build\n"), 0644)).To(Succeed())
+		Expect(addBundleCRD()).To(Succeed())
+		Expect(notes).To(Equal([]string{"added the bundle targets, which the Makefile lacked"}))
+		// The versions of a kind share the manifest, which is bundled once
+		res.Version = "v2"
+		Expect(addBundleCRD()).To(Succeed())
+		Expect(notes).To(BeEmpty())
+
+		makefile := readMakefile()
+		Expect(makefile).To(HavePrefix("all: docker-build\n\n##@ Bundle\n"))
+		Expect(makefile).To(ContainSubstring("IMAGE_TAG_BASE ?= example.com/memcached-operator\n"))
+		Expect(makefile).To(ContainSubstring(
+			"BUNDLE_CRDS =\nBUNDLE_CRDS += target/kubernetes/memcacheds.cache.example.com-v1.yml\n#+kubebuilder:scaffold:bundle-crds\n"))
+		Expect(makefile).To(ContainSubstring("\tcat $(BUNDLE_CRDS) target/kubernetes/kubernetes.yml | operator-sdk generate bundle"))
+	})
+
+	It("migrates Makefiles listing the CRD manifests in the bundle recipe", func() {
+		Expect(afero.WriteFile(fs.FS, makefileFile, []byte(legacyMakefile), 0644)).To(Succeed())
+
+		// The manifest listed in the recipe is not added again
+		Expect(addBundleCRD()).To(Succeed())
+		Expect(notes).To(Equal([]string{"migrated the bundle recipe to bundle the CRD manifests listed in BUNDLE_CRDS"}))
+		res.Kind, res.Plural = "Redis", "redis"
+		Expect(addBundleCRD()).To(Succeed())
+
+		makefile := readMakefile()
+		Expect(makefile).NotTo(ContainSubstring(legacyBundleMarker))
+		Expect(makefile).To(ContainSubstring(
+			"\tcat $(BUNDLE_CRDS) target/kubernetes/memcacheds.cache.example.com-v1.yml target/kubernetes/kubernetes.yml |"))
+		Expect(makefile).To(HaveSuffix(
+			"BUNDLE_CRDS =\nBUNDLE_CRDS += target/kubernetes/redis.cache.example.com-v1.yml\n#+kubebuilder:scaffold:bundle-crds\n"))
+	})
+
+	It("skips Makefiles with a bundle target of their own", func() {
+		custom := "bundle:\n\t./hack/bundle.sh\n"
+		Expect(afero.WriteFile(fs.FS, makefileFile, []byte(custom), 0644)).To(Succeed())
+		Expect(addBundleCRD()).To(Succeed())
+		Expect(notes).To(Equal([]string{"skipped adding target/kubernetes/memcacheds.cache.example.com-v1.yml to the " +
+			"bundle, as the Makefile lacks the \"#+kubebuilder:scaffold:bundle-crds\" marker"}))
+		Expect(readMakefile()).To(Equal(custom))
+	})
+
+	It("returns an error when the Makefile is missing", func() {
+		Expect(addBundleCRD()).To(MatchError(ContainSubstring("Makefile")))
+	})
+})
@@ -0,0 +1,119 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffolds
+
+import (
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+const pomFile = "pom.xml"
+
+// pomDependency is a Maven dependency that a scaffolder adds to an existing pom.xml
+type pomDependency struct {
+	GroupID    string
+	ArtifactID string
+	Version    string
+}
+
+func (d pomDependency) String() string {
+	var sb strings.Builder
+	sb.WriteString("    <dependency>\n")
+	sb.WriteString(fmt.Sprintf("      <groupId>%s</groupId>\n", d.GroupID))
+	sb.WriteString(fmt.Sprintf("      <artifactId>%s</artifactId>\n", d.ArtifactID))
+	if d.Version != "" {
+		sb.WriteString(fmt.Sprintf("      <version>%s</version>\n", d.Version))
+	}
+	sb.WriteString("    </dependency>\n")
+	return sb.String()
+}
+
+// pomProperty is a Maven property that a scaffolder adds to an existing pom.xml
+type pomProperty struct {
+	Name  string
+	Value string
+}
+
+// updatePom adds the given properties and dependencies to the project's pom.xml.
+// Properties and dependencies which are already declared are left untouched.
+func updatePom(fs machinery.Filesystem, properties []pomProperty, dependencies []pomDependency) error {
+	return updateFile(fs, pomFile, func(pom string) (string, error) {
+		var err error
+		for _, property := range properties {
+			if pom, err = addPomProperty(pom, property); err != nil {
+				return "", err
+			}
+		}
+		for _, dependency := range dependencies {
+			if pom, err = addPomDependency(pom, dependency); err != nil {
+				return "", err
+			}
+		}
+		return pom, nil
+	})
+}
+
+// addPomProperty adds the property to the first properties section of the pom
+func addPomProperty(pom string, property pomProperty) (string, error) {
+	if strings.Contains(pom, "<"+property.Name+">") {
+		return pom, nil
+	}
+
+	return insertBeforeLine(pom, "</properties>", 0,
+		fmt.Sprintf("    <%[1]s>%[2]s</%[1]s>\n", property.Name, property.Value))
+}
+
+// addPomDependency adds the dependency to the project dependencies, as opposed to the managed ones
+func addPomDependency(pom string, dependency pomDependency) (string, error) {
+	if strings.Contains(pom, "<artifactId>"+dependency.ArtifactID+"</artifactId>") {
+		return pom, nil
+	}
+
+	from := 0
+	if i := strings.Index(pom, "</dependencyManagement>"); i != -1 {
+		from = i
+	}
+	return insertBeforeLine(pom, "</dependencies>", from, dependency.String())
+}
+
+// insertBeforeLine inserts the fragment before the line holding the first occurrence of tag after from
+func insertBeforeLine(content, tag string, from int, fragment string) (string, error) {
+	i := strings.Index(content[from:], tag)
+	if i == -1 {
+		return "", fmt.Errorf("unable to find %q in %s", tag, pomFile)
+	}
+	lineStart := strings.LastIndex(content[:from+i], "\n") + 1
+
+	return content[:lineStart] + fragment + content[lineStart:], nil
+}
+
+// setPomElement sets the text of the first element with the given name, which for the elements
+// scaffolded by this plugin is the one declared by the project itself.
+func setPomElement(pom, name, value string) (string, error) {
+	start := strings.Index(pom, "<"+name+">")
+	if start == -1 {
+		return "", fmt.Errorf("unable to find <%s> in %s", name, pomFile)
+	}
+	start += len(name) + 2
+
+	end := strings.Index(pom[start:], "</"+name+">")
+	if end == -1 {
+		return "", fmt.Errorf("unable to find </%s> in %s", name, pomFile)
+	}
+
+	return pom[:start] + value + pom[start+end:], nil
+}
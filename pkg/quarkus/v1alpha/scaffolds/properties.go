@@ -0,0 +1,84 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffolds
+
+import (
+	"strings"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/templates/util"
+)
+
+var applicationPropertiesFile = util.PrependResourcePath("application.properties")
+
+// setProperty sets the value of key in the contents of a properties file, appending it if it is not declared
+func setProperty(contents, key, value string) string {
+	lines := strings.Split(contents, "\n")
+	for i, line := range lines {
+		if propertyKey(line) == key {
+			lines[i] = key + "=" + value
+			return strings.Join(lines, "\n")
+		}
+	}
+
+	if contents != "" && !strings.HasSuffix(contents, "\n") {
+		contents += "\n"
+	}
+	return contents + key + "=" + value + "\n"
+}
+
+// hasProperty returns true if key is declared in the contents of a properties file
+func hasProperty(contents, key string) bool {
+	for _, line := range strings.Split(contents, "\n") {
+		if propertyKey(line) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// renameProperty renames every declaration of key in the contents of a properties file, keeping its value
+func renameProperty(contents, key, newKey string) string {
+	lines := strings.Split(contents, "\n")
+	for i, line := range lines {
+		if propertyKey(line) == key {
+			lines[i] = strings.Replace(line, key, newKey, 1)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// removeProperty removes every declaration of key from the contents of a properties file
+func removeProperty(contents, key string) string {
+	lines := strings.Split(contents, "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		if propertyKey(line) != key {
+			kept = append(kept, line)
+		}
+	}
+	return strings.Join(kept, "\n")
+}
+
+// propertyKey returns the key declared by a properties file line, or an empty string for comments and blank lines
+func propertyKey(line string) string {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!") {
+		return ""
+	}
+	if i := strings.IndexAny(line, "=:"); i != -1 {
+		line = line[:i]
+	}
+	return strings.TrimSpace(line)
+}
@@ -0,0 +1,282 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffolds
+
+import (
+	"errors"
+	"fmt"
+	iofs "io/fs"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/afero"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v3/pkg/plugins"
+)
+
+const (
+	sourcesDir = "src"
+
+	contextImport       = "io.javaoperatorsdk.operator.api.reconciler.Context"
+	cleanerImport       = "io.javaoperatorsdk.operator.api.reconciler.Cleaner"
+	deleteControlImport = "io.javaoperatorsdk.operator.api.reconciler.DeleteControl"
+)
+
+var (
+	// eePackageRegexp matches the Java EE packages which Jakarta EE 9 moved as is to the jakarta namespace
+	eePackageRegexp = regexp.MustCompile(`\bjavax\.(inject|enterprise|ws\.rs|validation|json|persistence|interceptor|servlet|` +
+		`xml\.bind|activation|annotation\.(?:PostConstruct|PreDestroy|Priority|security)|transaction\.Transactional)\b`)
+
+	reconcilerRegexp = regexp.MustCompile(`\bReconciler<\s*([\w.]+)\s*>`)
+
+	// rawContextRegexps match the Context parameters without type arguments, in Java and Kotlin respectively
+	rawJavaContextRegexp   = regexp.MustCompile(`([(,]\s*(?:final\s+)?)Context(\s+\w+\s*[,)])`)
+	rawKotlinContextRegexp = regexp.MustCompile(`(\w+\s*:\s*)Context(\s*[,)=])`)
+)
+
+// UpgradeIssue is a file of the project which could not be migrated automatically
+type UpgradeIssue struct {
+	// Path is the path of the source file
+	Path string
+
+	// Reason describes what has to be migrated manually
+	Reason string
+}
+
+var _ plugins.Scaffolder = &UpgradeScaffolder{}
+
+// UpgradeScaffolder migrates the sources of a project from the versions of a configuration to the ones of another
+type UpgradeScaffolder struct {
+	fs machinery.Filesystem
+
+	from PluginConfig
+	to   PluginConfig
+
+	issues []UpgradeIssue
+	edited []string
+}
+
+// NewUpgradeScaffolder returns a new UpgradeScaffolder migrating the sources from the versions of from to the ones of to
+func NewUpgradeScaffolder(from, to PluginConfig) *UpgradeScaffolder {
+	return &UpgradeScaffolder{
+		from: from,
+		to:   to,
+	}
+}
+
+// InjectFS implements Scaffolder
+func (s *UpgradeScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Issues returns the files which have to be migrated manually
+func (s *UpgradeScaffolder) Issues() []UpgradeIssue {
+	return s.issues
+}
+
+// Edited returns the sources which were migrated
+func (s *UpgradeScaffolder) Edited() []string {
+	return s.edited
+}
+
+// Scaffold implements Scaffolder
+func (s *UpgradeScaffolder) Scaffold() error {
+	if err := s.checkWebhooksFramework(); err != nil {
+		return err
+	}
+	if err := s.migrateApplicationProperties(); err != nil {
+		return err
+	}
+
+	if exists, err := afero.DirExists(s.fs.FS, sourcesDir); err != nil || !exists {
+		return err
+	}
+
+	var paths []string
+	err := afero.Walk(s.fs.FS, sourcesDir, func(path string, info iofs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if ext := filepath.Ext(path); !info.IsDir() && (ext == ".java" || ext == ".kt") {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		path := path
+		if err := updateFile(s.fs, path, func(source string) (string, error) {
+			migrated := s.migrate(path, source)
+			if migrated != source {
+				s.edited = append(s.edited, path)
+			}
+			return migrated, nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkWebhooksFramework reports the version of the webhooks framework the project depends on when the major
+// version of quarkus-operator-sdk changes, as each release of the framework is built on the Kubernetes client of a
+// given java-operator-sdk release
+func (s *UpgradeScaffolder) checkWebhooksFramework() error {
+	fromVersion, toVersion := s.from.OperatorSDKVersion, s.to.OperatorSDKVersion
+	if fromVersion == "" {
+		fromVersion = DefaultOperatorSDKVersion
+	}
+	if toVersion == "" {
+		toVersion = DefaultOperatorSDKVersion
+	}
+	if majorVersion(fromVersion) == majorVersion(toVersion) {
+		return nil
+	}
+
+	path, property := pomFile, "<"+webhooksVersionProperty+">"
+	if s.to.IsGradle() {
+		path, property = gradlePropertiesFile, gradleWebhooksVersionProperty
+	}
+	contents, err := afero.ReadFile(s.fs.FS, path)
+	if err != nil {
+		if errors.Is(err, iofs.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	if !strings.Contains(string(contents), property) {
+		return nil
+	}
+
+	s.issues = append(s.issues, UpgradeIssue{Path: path, Reason: fmt.Sprintf(
+		"the version of %s has to be updated to a release built on the Kubernetes client of quarkus-operator-sdk %s",
+		webhooksFrameworkArtifact, toVersion)})
+	return nil
+}
+
+// migrateApplicationProperties moves the properties of the csv generator to the bundle generator which replaced it,
+// recording the ones which have no known counterpart
+func (s *UpgradeScaffolder) migrateApplicationProperties() error {
+	if s.from.BundleGenerator() != csvGeneratorArtifact || s.to.BundleGenerator() != bundleGeneratorArtifact {
+		return nil
+	}
+	if exists, err := afero.Exists(s.fs.FS, applicationPropertiesFile); err != nil || !exists {
+		return err
+	}
+
+	return updateFile(s.fs, applicationPropertiesFile, func(properties string) (string, error) {
+		migrated := renameProperty(properties, generateCSVProperty, bundleEnabledProperty)
+		if migrated != properties {
+			s.edited = append(s.edited, applicationPropertiesFile)
+		}
+
+		for _, line := range strings.Split(migrated, "\n") {
+			if key := propertyKey(line); strings.HasPrefix(key, csvPropertiesPrefix) {
+				s.issues = append(s.issues, UpgradeIssue{Path: applicationPropertiesFile, Reason: fmt.Sprintf(
+					"declares %s, which the %s no longer reads and has to be migrated by hand", key,
+					bundleGeneratorArtifact)})
+			}
+		}
+		return migrated, nil
+	})
+}
+
+// migrate returns the source migrated to the new versions, recording what could not be migrated
+func (s *UpgradeScaffolder) migrate(path, source string) string {
+	if s.from.EENamespace() == javaxNamespace && s.to.EENamespace() == jakartaNamespace {
+		source = eePackageRegexp.ReplaceAllString(source, jakartaNamespace+".$1")
+	}
+
+	if !s.from.GenericContext() && s.to.GenericContext() {
+		source = s.migrateContext(path, source)
+	}
+
+	if !s.from.CleanerInterface() && s.to.CleanerInterface() && s.to.HasCleanup() {
+		source = s.migrateCleaner(path, source)
+	}
+
+	return source
+}
+
+// migrateContext types the Context parameters of a reconciler by its custom resource
+func (s *UpgradeScaffolder) migrateContext(path, source string) string {
+	if !strings.Contains(source, contextImport) {
+		return source
+	}
+
+	rawContextRegexp := rawJavaContextRegexp
+	if filepath.Ext(path) == ".kt" {
+		rawContextRegexp = rawKotlinContextRegexp
+	}
+	if !rawContextRegexp.MatchString(source) {
+		return source
+	}
+
+	resource, ok := reconciledResource(source)
+	if !ok {
+		s.issues = append(s.issues, UpgradeIssue{
+			Path:   path,
+			Reason: "uses Context without type arguments, which have to be added by hand as the custom resource is not known",
+		})
+		return source
+	}
+
+	return rawContextRegexp.ReplaceAllString(source, "${1}Context<"+resource+">${2}")
+}
+
+// migrateCleaner makes the reconcilers which override the cleanup method of Reconciler implement Cleaner, which
+// declares it as of java-operator-sdk 4
+func (s *UpgradeScaffolder) migrateCleaner(path, source string) string {
+	if !strings.Contains(source, deleteControlImport) || strings.Contains(source, cleanerImport) {
+		return source
+	}
+
+	contextImportLine := "import " + contextImport
+	resource, ok := reconciledResource(source)
+	if !ok || !strings.Contains(source, contextImportLine) {
+		s.issues = append(s.issues, UpgradeIssue{
+			Path:   path,
+			Reason: "cleans up its custom resources, which requires implementing Cleaner by hand",
+		})
+		return source
+	}
+
+	cleanerImportLine := "import " + cleanerImport
+	if filepath.Ext(path) == ".java" {
+		cleanerImportLine += ";"
+	}
+	source = strings.Replace(source, contextImportLine, cleanerImportLine+"\n"+contextImportLine, 1)
+
+	match := reconcilerRegexp.FindStringIndex(source)
+	return source[:match[1]] + ", Cleaner<" + resource + ">" + source[match[1]:]
+}
+
+// reconciledResource returns the custom resource the source reconciles, if it implements Reconciler for a single one
+func reconciledResource(source string) (string, bool) {
+	var resources []string
+	for _, match := range reconcilerRegexp.FindAllStringSubmatch(source, -1) {
+		if len(resources) == 0 || resources[0] != match[1] {
+			resources = append(resources, match[1])
+		}
+	}
+	if len(resources) != 1 {
+		return "", false
+	}
+	return resources[0], true
+}
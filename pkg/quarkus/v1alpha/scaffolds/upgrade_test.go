@@ -0,0 +1,242 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffolds
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+const (
+	javaReconcilerPath = "src/main/java/com/example/MemcachedReconciler.java"
+	javaReconciler     = `package com.example;
+
+import com.example.v1.Memcached;
+import io.javaoperatorsdk.operator.api.reconciler.Context;
+import io.javaoperatorsdk.operator.api.reconciler.Reconciler;
+import io.javaoperatorsdk.operator.api.reconciler.UpdateControl;
+import javax.inject.Inject;
+
+public class MemcachedReconciler implements Reconciler<Memcached> {
+  @Override
+  public UpdateControl<Memcached> reconcile(Memcached resource, Context context) {
+    return UpdateControl.noUpdate();
+  }
+}
+`
+
+	kotlinReconcilerPath = "src/main/kotlin/com/example/MemcachedReconciler.kt"
+	kotlinReconciler     = `package com.example
+
+import com.example.v1.Memcached
+import io.javaoperatorsdk.operator.api.reconciler.Context
+import io.javaoperatorsdk.operator.api.reconciler.Reconciler
+import io.javaoperatorsdk.operator.api.reconciler.UpdateControl
+
+class MemcachedReconciler : Reconciler<Memcached> {
+    override fun reconcile(resource: Memcached, context: Context): UpdateControl<Memcached> {
+        return UpdateControl.noUpdate()
+    }
+}
+`
+
+	reconcilersPath = "src/main/java/com/example/Reconcilers.java"
+	reconcilers     = `package com.example;
+
+import io.javaoperatorsdk.operator.api.reconciler.Context;
+import io.javaoperatorsdk.operator.api.reconciler.Reconciler;
+import javax.crypto.Cipher;
+
+public class Reconcilers {
+  public static class MemcachedReconciler implements Reconciler<Memcached> {
+    public UpdateControl<Memcached> reconcile(Memcached resource, Context context) {
+      return UpdateControl.noUpdate();
+    }
+  }
+
+  public static class RedisReconciler implements Reconciler<Redis> {
+    public UpdateControl<Redis> reconcile(Redis resource, Context context) {
+      return UpdateControl.noUpdate();
+    }
+  }
+}
+`
+)
+
+var _ = Describe("UpgradeScaffolder", func() {
+	var (
+		fs     machinery.Filesystem
+		edited []string
+	)
+
+	readFile := func(path string) string {
+		contents, err := afero.ReadFile(fs.FS, path)
+		Expect(err).NotTo(HaveOccurred())
+		return string(contents)
+	}
+
+	upgrade := func(from, to PluginConfig) []UpgradeIssue {
+		scaffolder := NewUpgradeScaffolder(from, to)
+		scaffolder.InjectFS(fs)
+		Expect(scaffolder.Scaffold()).To(Succeed())
+		edited = scaffolder.Edited()
+		return scaffolder.Issues()
+	}
+
+	BeforeEach(func() {
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		for path, source := range map[string]string{
+			javaReconcilerPath:   javaReconciler,
+			kotlinReconcilerPath: kotlinReconciler,
+			reconcilersPath:      reconcilers,
+		} {
+			Expect(afero.WriteFile(fs.FS, path, []byte(source), 0644)).To(Succeed())
+		}
+	})
+
+	It("migrates the sources to jakarta and to the typed Context", func() {
+		issues := upgrade(
+			PluginConfig{QuarkusVersion: DefaultQuarkusVersion, OperatorSDKVersion: DefaultOperatorSDKVersion},
+			PluginConfig{QuarkusVersion: LatestQuarkusVersion, OperatorSDKVersion: LatestOperatorSDKVersion},
+		)
+
+		java := readFile(javaReconcilerPath)
+		Expect(java).To(ContainSubstring("import jakarta.inject.Inject;"))
+		Expect(java).To(ContainSubstring("reconcile(Memcached resource, Context<Memcached> context)"))
+
+		Expect(readFile(kotlinReconcilerPath)).To(ContainSubstring(
+			"reconcile(resource: Memcached, context: Context<Memcached>): UpdateControl<Memcached>"))
+
+		// The reconcilers of several resources cannot be migrated, unlike the JDK javax packages
+		Expect(readFile(reconcilersPath)).To(ContainSubstring("Memcached resource, Context context"))
+		Expect(readFile(reconcilersPath)).To(ContainSubstring("import javax.crypto.Cipher;"))
+		Expect(issues).To(HaveLen(1))
+		Expect(issues[0].Path).To(Equal(reconcilersPath))
+		Expect(edited).To(ContainElements(javaReconcilerPath, kotlinReconcilerPath))
+	})
+
+	It("makes the reconcilers which clean up their custom resources implement Cleaner", func() {
+		cleanup := map[string]ReconcilerOptions{"memcacheds.cache.example.com": {Cleanup: true}}
+		for path, source := range map[string]string{
+			javaReconcilerPath: `package com.example;
+
+import com.example.v1.Memcached;
+import io.javaoperatorsdk.operator.api.reconciler.Context;
+import io.javaoperatorsdk.operator.api.reconciler.DeleteControl;
+import io.javaoperatorsdk.operator.api.reconciler.Reconciler;
+
+public class MemcachedReconciler implements Reconciler<Memcached> {
+  @Override
+  public DeleteControl cleanup(Memcached resource, Context<Memcached> context) {
+    return DeleteControl.defaultDelete();
+  }
+}
+`,
+			kotlinReconcilerPath: `package com.example
+
+import com.example.v1.Memcached
+import io.javaoperatorsdk.operator.api.reconciler.Context
+import io.javaoperatorsdk.operator.api.reconciler.DeleteControl
+import io.javaoperatorsdk.operator.api.reconciler.Reconciler
+
+class MemcachedReconciler : Reconciler<Memcached> {
+    override fun cleanup(resource: Memcached, context: Context<Memcached>): DeleteControl {
+        return DeleteControl.defaultDelete()
+    }
+}
+`,
+		} {
+			Expect(afero.WriteFile(fs.FS, path, []byte(source), 0644)).To(Succeed())
+		}
+
+		Expect(upgrade(
+			PluginConfig{OperatorSDKVersion: "4.0.5", Reconcilers: cleanup},
+			PluginConfig{OperatorSDKVersion: LatestOperatorSDKVersion, Reconcilers: cleanup},
+		)).To(BeEmpty())
+
+		java := readFile(javaReconcilerPath)
+		Expect(java).To(ContainSubstring("import io.javaoperatorsdk.operator.api.reconciler.Cleaner;\n" +
+			"import io.javaoperatorsdk.operator.api.reconciler.Context;\n"))
+		Expect(java).To(ContainSubstring("implements Reconciler<Memcached>, Cleaner<Memcached> {"))
+
+		kotlin := readFile(kotlinReconcilerPath)
+		Expect(kotlin).To(ContainSubstring("import io.javaoperatorsdk.operator.api.reconciler.Cleaner\n" +
+			"import io.javaoperatorsdk.operator.api.reconciler.Context\n"))
+		Expect(kotlin).To(ContainSubstring(": Reconciler<Memcached>, Cleaner<Memcached> {"))
+
+		// The reconcilers without cleanup are left as is
+		Expect(readFile(reconcilersPath)).To(Equal(reconcilers))
+	})
+
+	It("reports the version of the webhooks framework when the quarkus-operator-sdk major version changes", func() {
+		Expect(afero.WriteFile(fs.FS, "pom.xml",
+			[]byte("<properties>\n  <josdk-webhooks.version>1.0.0</josdk-webhooks.version>\n</properties>\n"), 0644)).To(Succeed())
+
+		issues := upgrade(
+			PluginConfig{QuarkusVersion: "2.16.12.Final", OperatorSDKVersion: "5.1.5"},
+			PluginConfig{QuarkusVersion: "2.16.12.Final", OperatorSDKVersion: "5.1.6"},
+		)
+		Expect(issues).To(BeEmpty())
+
+		issues = upgrade(
+			PluginConfig{QuarkusVersion: DefaultQuarkusVersion, OperatorSDKVersion: DefaultOperatorSDKVersion},
+			PluginConfig{QuarkusVersion: LatestQuarkusVersion, OperatorSDKVersion: LatestOperatorSDKVersion},
+		)
+		Expect(issues).To(ContainElement(UpgradeIssue{
+			Path: "pom.xml",
+			Reason: "the version of kubernetes-webhooks-framework-core has to be updated to a release built on " +
+				"the Kubernetes client of quarkus-operator-sdk " + LatestOperatorSDKVersion,
+		}))
+	})
+
+	It("moves the properties of the csv generator to the bundle generator", func() {
+		path := "src/main/resources/application.properties"
+		Expect(afero.WriteFile(fs.FS, path, []byte("quarkus.operator-sdk.crd.apply=false\n"+
+			"quarkus.operator-sdk.generate-csv = true\n"+
+			"quarkus.operator-sdk.csv.manifests.path=manifests\n"), 0644)).To(Succeed())
+
+		issues := upgrade(
+			PluginConfig{QuarkusVersion: DefaultQuarkusVersion, OperatorSDKVersion: DefaultOperatorSDKVersion},
+			PluginConfig{QuarkusVersion: LatestQuarkusVersion, OperatorSDKVersion: LatestOperatorSDKVersion},
+		)
+		Expect(readFile(path)).To(Equal("quarkus.operator-sdk.crd.apply=false\n" +
+			"quarkus.operator-sdk.bundle.enabled = true\n" +
+			"quarkus.operator-sdk.csv.manifests.path=manifests\n"))
+		Expect(edited).To(ContainElement(path))
+		Expect(issues).To(ContainElement(UpgradeIssue{
+			Path: path,
+			Reason: "declares quarkus.operator-sdk.csv.manifests.path, which the quarkus-operator-sdk-bundle-generator " +
+				"no longer reads and has to be migrated by hand",
+		}))
+	})
+
+	It("leaves the sources untouched when the versions do not require it", func() {
+		Expect(upgrade(
+			PluginConfig{QuarkusVersion: DefaultQuarkusVersion},
+			PluginConfig{QuarkusVersion: "2.7.6.Final"},
+		)).To(BeEmpty())
+
+		Expect(readFile(javaReconcilerPath)).To(Equal(javaReconciler))
+		Expect(readFile(kotlinReconcilerPath)).To(Equal(kotlinReconciler))
+		Expect(edited).To(BeEmpty())
+	})
+
+	It("is a no-op on projects without sources", func() {
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		Expect(upgrade(PluginConfig{}, PluginConfig{QuarkusVersion: LatestQuarkusVersion})).To(BeEmpty())
+	})
+})
@@ -0,0 +1,195 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffolds
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultOperatorVersion is the version new operators start at
+	DefaultOperatorVersion = "0.0.1"
+
+	// LatestQuarkusVersion and LatestOperatorSDKVersion are the most recent releases known to work together,
+	// which projects are upgraded to by default
+	LatestQuarkusVersion     = "3.8.4"
+	LatestOperatorSDKVersion = "6.6.4"
+
+	// minJavaVersion is the lowest Java release any of the known Quarkus versions runs on
+	minJavaVersion = 11
+
+	// javaxNamespace and jakartaNamespace are the packages of the Java EE and Jakarta EE 9+ APIs respectively
+	javaxNamespace   = "javax"
+	jakartaNamespace = "jakarta"
+
+	// csvGeneratorArtifact and bundleGeneratorArtifact are the artifacts generating the OLM bundle before and as of
+	// quarkus-operator-sdk 4 respectively
+	csvGeneratorArtifact    = "quarkus-operator-sdk-csv-generator"
+	bundleGeneratorArtifact = "quarkus-operator-sdk-bundle-generator"
+
+	// generateCSVProperty and bundleEnabledProperty toggle the generation of the OLM bundle before and as of
+	// quarkus-operator-sdk 4 respectively, csvPropertiesPrefix prefixing the other properties of the csv generator
+	generateCSVProperty   = "quarkus.operator-sdk.generate-csv"
+	bundleEnabledProperty = "quarkus.operator-sdk.bundle.enabled"
+	csvPropertiesPrefix   = "quarkus.operator-sdk.csv."
+)
+
+// compatibility is a combination of quarkus-operator-sdk and Quarkus releases known to work together
+type compatibility struct {
+	// OperatorSDKVersion and QuarkusVersion are the <major>.<minor> versions of the releases
+	OperatorSDKVersion string
+	QuarkusVersion     string
+
+	// MinJavaVersion is the lowest Java release the combination runs on
+	MinJavaVersion int
+}
+
+// compatibilities lists the known-compatible combinations, each quarkus-operator-sdk release
+// being built against a given Quarkus release
+var compatibilities = []compatibility{
+	{OperatorSDKVersion: "3.0", QuarkusVersion: "2.7", MinJavaVersion: 11},
+	{OperatorSDKVersion: "4.0", QuarkusVersion: "2.10", MinJavaVersion: 11},
+	{OperatorSDKVersion: "4.0", QuarkusVersion: "2.11", MinJavaVersion: 11},
+	{OperatorSDKVersion: "5.0", QuarkusVersion: "2.13", MinJavaVersion: 11},
+	{OperatorSDKVersion: "5.1", QuarkusVersion: "2.16", MinJavaVersion: 11},
+	{OperatorSDKVersion: "6.0", QuarkusVersion: "3.0", MinJavaVersion: 11},
+	{OperatorSDKVersion: "6.3", QuarkusVersion: "3.2", MinJavaVersion: 11},
+	{OperatorSDKVersion: "6.6", QuarkusVersion: "3.8", MinJavaVersion: 17},
+}
+
+// CheckVersions checks that the Quarkus, quarkus-operator-sdk and Java versions work together.
+// It returns an error if they are known not to, and a warning if the combination is unknown.
+func CheckVersions(quarkusVersion, operatorSDKVersion, javaVersion string) (string, error) {
+	quarkus, err := minorVersion(quarkusVersion)
+	if err != nil {
+		return "", fmt.Errorf("invalid Quarkus version: %w", err)
+	}
+	operatorSDK, err := minorVersion(operatorSDKVersion)
+	if err != nil {
+		return "", fmt.Errorf("invalid quarkus-operator-sdk version: %w", err)
+	}
+	java, err := strconv.Atoi(javaVersion)
+	if err != nil {
+		return "", fmt.Errorf("invalid Java version %q, expected a release number such as %s", javaVersion, DefaultJavaVersion)
+	}
+	if java < minJavaVersion {
+		return "", fmt.Errorf("Java %d is not supported, the lowest supported release is %d", java, minJavaVersion)
+	}
+
+	var quarkusVersions []string
+	for _, c := range compatibilities {
+		if c.OperatorSDKVersion != operatorSDK {
+			continue
+		}
+		if c.QuarkusVersion == quarkus {
+			if java < c.MinJavaVersion {
+				return "", fmt.Errorf("Quarkus %s requires Java %d or later", quarkusVersion, c.MinJavaVersion)
+			}
+			return "", nil
+		}
+		quarkusVersions = append(quarkusVersions, c.QuarkusVersion)
+	}
+	if len(quarkusVersions) > 0 {
+		return "", fmt.Errorf("quarkus-operator-sdk %s is built against Quarkus %s, not %s",
+			operatorSDKVersion, strings.Join(quarkusVersions, " or "), quarkusVersion)
+	}
+
+	return fmt.Sprintf("the compatibility of quarkus-operator-sdk %s with Quarkus %s is unknown, "+
+		"check the quarkus-operator-sdk release notes for the Quarkus version it requires", operatorSDKVersion, quarkusVersion), nil
+}
+
+// RequiredJavaVersion returns the lowest Java release the Quarkus and quarkus-operator-sdk versions run on
+func RequiredJavaVersion(quarkusVersion, operatorSDKVersion string) int {
+	quarkus, err := minorVersion(quarkusVersion)
+	if err != nil {
+		return minJavaVersion
+	}
+	operatorSDK, err := minorVersion(operatorSDKVersion)
+	if err != nil {
+		return minJavaVersion
+	}
+
+	for _, c := range compatibilities {
+		if c.OperatorSDKVersion == operatorSDK && c.QuarkusVersion == quarkus {
+			return c.MinJavaVersion
+		}
+	}
+	return minJavaVersion
+}
+
+// minorVersion returns the <major>.<minor> part of the version
+func minorVersion(version string) (string, error) {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return "", fmt.Errorf("%q is not of the form <major>.<minor>[.<patch>]", version)
+	}
+	for _, part := range parts[:2] {
+		if _, err := strconv.Atoi(part); err != nil {
+			return "", fmt.Errorf("%q is not of the form <major>.<minor>[.<patch>]", version)
+		}
+	}
+	return parts[0] + "." + parts[1], nil
+}
+
+// majorVersion returns the major part of the version, or 0 if it is malformed
+func majorVersion(version string) int {
+	major, err := strconv.Atoi(strings.SplitN(version, ".", 2)[0])
+	if err != nil {
+		return 0
+	}
+	return major
+}
+
+// eeNamespace returns the package of the enterprise Java APIs of the Quarkus version, Quarkus 3
+// having moved from Java EE to Jakarta EE 10
+func eeNamespace(quarkusVersion string) string {
+	if majorVersion(quarkusVersion) >= 3 {
+		return jakartaNamespace
+	}
+	return javaxNamespace
+}
+
+// cleanerInterface returns whether the reconcilers of the quarkus-operator-sdk version clean up the custom resources
+// by implementing Cleaner, as they do as of the 5.0 release built on java-operator-sdk 4, rather than by overriding
+// the cleanup method of Reconciler
+func cleanerInterface(operatorSDKVersion string) bool {
+	if operatorSDKVersion == "" {
+		operatorSDKVersion = DefaultOperatorSDKVersion
+	}
+	return majorVersion(operatorSDKVersion) >= 5
+}
+
+// genericContext returns whether the reconcilers of the quarkus-operator-sdk version receive a Context
+// typed by their custom resource, as they do as of the 4.0 release built on java-operator-sdk 3
+func genericContext(operatorSDKVersion string) bool {
+	if operatorSDKVersion == "" {
+		operatorSDKVersion = DefaultOperatorSDKVersion
+	}
+	return majorVersion(operatorSDKVersion) >= 4
+}
+
+// bundleGenerator returns the artifact of the quarkus-operator-sdk version generating the OLM bundle, the csv
+// generator having been replaced by the bundle generator in the 4.0 release
+func bundleGenerator(operatorSDKVersion string) string {
+	if operatorSDKVersion == "" {
+		operatorSDKVersion = DefaultOperatorSDKVersion
+	}
+	if majorVersion(operatorSDKVersion) >= 4 {
+		return bundleGeneratorArtifact
+	}
+	return csvGeneratorArtifact
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffolds
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckVersions", func() {
+	It("accepts the default versions", func() {
+		warning, err := CheckVersions(DefaultQuarkusVersion, DefaultOperatorSDKVersion, DefaultJavaVersion)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warning).To(BeEmpty())
+	})
+
+	It("accepts a known combination with a later Java release", func() {
+		warning, err := CheckVersions("3.8.4", "6.6.4", "21")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warning).To(BeEmpty())
+	})
+
+	It("rejects a Quarkus version the quarkus-operator-sdk version is not built against", func() {
+		_, err := CheckVersions("3.8.4", "3.0.7", "17")
+		Expect(err).To(MatchError(ContainSubstring("built against Quarkus 2.7")))
+	})
+
+	It("rejects a Java release older than the one the combination requires", func() {
+		_, err := CheckVersions("3.8.4", "6.6.4", "11")
+		Expect(err).To(MatchError(ContainSubstring("requires Java 17")))
+
+		_, err = CheckVersions(DefaultQuarkusVersion, DefaultOperatorSDKVersion, "8")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects malformed versions", func() {
+		_, err := CheckVersions("latest", DefaultOperatorSDKVersion, DefaultJavaVersion)
+		Expect(err).To(HaveOccurred())
+		_, err = CheckVersions(DefaultQuarkusVersion, "3", DefaultJavaVersion)
+		Expect(err).To(HaveOccurred())
+		_, err = CheckVersions(DefaultQuarkusVersion, DefaultOperatorSDKVersion, "1.8")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("warns about an unknown combination", func() {
+		warning, err := CheckVersions("3.15.1", "6.8.0", "17")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warning).To(ContainSubstring("unknown"))
+	})
+})
+
+var _ = Describe("EENamespace", func() {
+	It("uses the javax packages before Quarkus 3", func() {
+		Expect(PluginConfig{QuarkusVersion: "2.16.12.Final"}.EENamespace()).To(Equal("javax"))
+		Expect(PluginConfig{}.EENamespace()).To(Equal("javax"))
+	})
+
+	It("uses the jakarta packages as of Quarkus 3", func() {
+		Expect(PluginConfig{QuarkusVersion: "3.8.4"}.EENamespace()).To(Equal("jakarta"))
+	})
+})
+
+var _ = Describe("BundleGenerator", func() {
+	It("uses the csv generator before quarkus-operator-sdk 4", func() {
+		Expect(PluginConfig{OperatorSDKVersion: "3.0.7"}.BundleGenerator()).To(Equal("quarkus-operator-sdk-csv-generator"))
+		Expect(PluginConfig{}.BundleGenerator()).To(Equal("quarkus-operator-sdk-csv-generator"))
+	})
+
+	It("uses the bundle generator as of quarkus-operator-sdk 4", func() {
+		Expect(PluginConfig{OperatorSDKVersion: "4.0.0"}.BundleGenerator()).To(Equal("quarkus-operator-sdk-bundle-generator"))
+		Expect(PluginConfig{OperatorSDKVersion: "6.6.4"}.BundleGenerator()).To(Equal("quarkus-operator-sdk-bundle-generator"))
+	})
+})
@@ -0,0 +1,192 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffolds
+
+import (
+	"github.com/spf13/afero"
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v3/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v3/pkg/plugins"
+
+	templatesutil "github.com/operator-framework/java-operator-plugins/pkg/internal/templates/util"
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/scaffolds/internal/templates/webhook"
+)
+
+const (
+	// webhooksFrameworkVersion and webhooksFrameworkArtifact are the version and the artifact of the
+	// java-operator-sdk webhooks framework added to the project
+	webhooksFrameworkVersion  = "1.0.0"
+	webhooksFrameworkArtifact = "kubernetes-webhooks-framework-core"
+
+	// webhooksVersionProperty and gradleWebhooksVersionProperty hold the version of the webhooks framework in
+	// pom.xml and gradle.properties respectively
+	webhooksVersionProperty       = "josdk-webhooks.version"
+	gradleWebhooksVersionProperty = "josdkWebhooksVersion"
+)
+
+var _ plugins.Scaffolder = &CreateWebhookScaffolder{}
+
+// CreateWebhookScaffolder scaffolds the webhooks of a version of a kind
+type CreateWebhookScaffolder struct {
+	fs machinery.Filesystem
+
+	config       config.Config
+	pluginConfig PluginConfig
+	resource     resource.Resource
+
+	// force indicates whether to scaffold webhook files even if they exist
+	force bool
+
+	edited []string
+}
+
+// NewCreateWebhookScaffolder returns a new plugins.Scaffolder for webhook creation operations
+func NewCreateWebhookScaffolder(cfg config.Config, pluginConfig PluginConfig, res resource.Resource, force bool) *CreateWebhookScaffolder {
+	return &CreateWebhookScaffolder{
+		config:       cfg,
+		pluginConfig: pluginConfig,
+		resource:     res,
+		force:        force,
+	}
+}
+
+// InjectFS implements Scaffolder
+func (s *CreateWebhookScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// hubPackage returns the package of the models of the storage version, which the other versions convert to and from
+func (s *CreateWebhookScaffolder) hubPackage() string {
+	hub := s.resource
+	if version := s.pluginConfig.StorageVersion(s.resource); version != "" {
+		hub.Version = version
+	}
+	return s.pluginConfig.ModelPackage(hub, s.config.IsMultiGroup())
+}
+
+// Edited returns the existing sources the scaffolding updated in place, such as the endpoint of the conversion
+// webhook of the kind
+func (s *CreateWebhookScaffolder) Edited() []string {
+	return s.edited
+}
+
+// kindVersions returns the resources of the project which are versions of the kind of res, including res itself
+func kindVersions(cfg config.Config, res resource.Resource) ([]resource.Resource, error) {
+	resources, err := cfg.GetResources()
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []resource.Resource
+	for _, other := range resources {
+		if other.QualifiedGroup() == res.QualifiedGroup() && other.Kind == res.Kind {
+			versions = append(versions, other)
+		}
+	}
+	return versions, nil
+}
+
+// Scaffold implements Scaffolder
+func (s *CreateWebhookScaffolder) Scaffold() error {
+	if err := s.config.UpdateResource(s.resource); err != nil {
+		return err
+	}
+
+	// The endpoint serves every webhook of the resource, including the ones scaffolded by previous runs
+	res, err := s.config.GetResource(s.resource.GVK)
+	if err != nil {
+		return err
+	}
+
+	// Initialize the machinery.Scaffold that will write the files to disk
+	scaffold := machinery.NewScaffold(s.fs,
+		// NOTE: kubebuilder's default permissions are only for root users
+		machinery.WithDirectoryPermissions(0755),
+		machinery.WithFilePermissions(0644),
+		machinery.WithConfig(s.config),
+		machinery.WithResource(&res),
+	)
+
+	// The webhooks of each version of a kind are scaffolded along with its models
+	pkg := s.pluginConfig.ModelPackage(s.resource, s.config.IsMultiGroup())
+	className := ModelClassName(s.resource.Kind)
+
+	var webhookTemplates []machinery.Builder
+	if res.HasDefaultingWebhook() || res.HasValidationWebhook() {
+		webhookTemplates = append(webhookTemplates,
+			&webhook.Endpoint{Package: pkg, ClassName: className, EENamespace: s.pluginConfig.EENamespace()})
+	}
+	if s.resource.HasDefaultingWebhook() {
+		webhookTemplates = append(webhookTemplates,
+			&webhook.Defaulter{Package: pkg, ClassName: className, Force: s.force})
+	}
+	if s.resource.HasValidationWebhook() {
+		webhookTemplates = append(webhookTemplates,
+			&webhook.Validator{Package: pkg, ClassName: className, Force: s.force})
+	}
+	if s.resource.HasConversionWebhook() {
+		webhookTemplates = append(webhookTemplates,
+			&webhook.Conversion{Package: pkg, HubPackage: s.hubPackage(), ClassName: className, Force: s.force})
+
+		// A single endpoint serves the conversion webhook of the kind, as its route does not depend on the version.
+		// It is generated along with the first conversion webhook, then each version registers its mapper.
+		resourcePackage := s.pluginConfig.ResourcePackage(s.resource, s.config.IsMultiGroup())
+		endpointPath := templatesutil.PrependJavaPath(className+"ConversionEndpoint.java",
+			templatesutil.AsPath(resourcePackage))
+		exists, err := afero.Exists(s.fs.FS, endpointPath)
+		if err != nil {
+			return err
+		}
+		if exists {
+			s.edited = append(s.edited, endpointPath)
+		}
+		webhookTemplates = append(webhookTemplates, &webhook.ConversionEndpoint{
+			Package:     resourcePackage,
+			ClassName:   className,
+			EENamespace: s.pluginConfig.EENamespace(),
+		}, &webhook.ConversionEndpointUpdater{
+			Package:   resourcePackage,
+			ClassName: className,
+			Mapper:    pkg + "." + className + "Mapper",
+		})
+	}
+
+	if err := scaffold.Execute(webhookTemplates...); err != nil {
+		return err
+	}
+
+	if s.pluginConfig.IsGradle() {
+		return updateGradle(s.fs,
+			[]pomProperty{
+				{Name: gradleWebhooksVersionProperty, Value: webhooksFrameworkVersion},
+			},
+			[]string{
+				`io.javaoperatorsdk:kubernetes-webhooks-framework-core:${property("josdkWebhooksVersion")}`,
+				`io.quarkus:quarkus-resteasy-reactive-jackson:${quarkusVersion}`,
+			},
+		)
+	}
+
+	return updatePom(s.fs,
+		[]pomProperty{
+			{Name: webhooksVersionProperty, Value: webhooksFrameworkVersion},
+		},
+		[]pomDependency{
+			{GroupID: "io.javaoperatorsdk", ArtifactID: webhooksFrameworkArtifact, Version: "${josdk-webhooks.version}"},
+			{GroupID: "io.quarkus", ArtifactID: "quarkus-resteasy-reactive-jackson", Version: "${quarkus.version}"},
+		},
+	)
+}
@@ -0,0 +1,166 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffolds
+
+import (
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	v3 "sigs.k8s.io/kubebuilder/v3/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v3/pkg/model/resource"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/scaffolds/internal/templates"
+)
+
+var _ = Describe("webhookScaffolder", func() {
+	var (
+		fs  machinery.Filesystem
+		cfg config.Config
+		res resource.Resource
+
+		pluginConfig = PluginConfig{Package: "com.example"}
+	)
+
+	javaFile := func(name string) string {
+		return filepath.Join("src", "main", "java", "com", "example", "v1", name)
+	}
+
+	BeforeEach(func() {
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		cfg = v3.New()
+		Expect(cfg.SetDomain("example.com")).To(Succeed())
+		Expect(cfg.SetProjectName("memcached-operator")).To(Succeed())
+
+		res = resource.Resource{
+			GVK:    resource.GVK{Group: "cache", Domain: "example.com", Version: "v1", Kind: "Memcached"},
+			Plural: "memcacheds",
+			API:    &resource.API{CRDVersion: "v1", Namespaced: true},
+		}
+		Expect(cfg.AddResource(res)).To(Succeed())
+
+		Expect(machinery.NewScaffold(fs).Execute(&templates.PomXmlFile{
+			Package:         "com.example",
+			ProjectName:     "memcached-operator",
+			OperatorVersion: "0.0.1",
+			QuarkusVersion:  DefaultQuarkusVersion,
+		})).To(Succeed())
+	})
+
+	It("scaffolds the requested webhooks and their dependencies", func() {
+		res.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Defaulting: true}
+		scaffolder := NewCreateWebhookScaffolder(cfg, pluginConfig, res, false)
+		scaffolder.InjectFS(fs)
+		Expect(scaffolder.Scaffold()).To(Succeed())
+
+		Expect(afero.Exists(fs.FS, javaFile("MemcachedDefaulter.java"))).To(BeTrue())
+		Expect(afero.Exists(fs.FS, javaFile("MemcachedValidator.java"))).To(BeFalse())
+
+		endpoint, err := afero.ReadFile(fs.FS, javaFile("MemcachedWebhookEndpoint.java"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(endpoint)).To(ContainSubstring(`@Path("mutate-cache-example-com-v1-memcached")`))
+		Expect(string(endpoint)).NotTo(ContainSubstring("validate-"))
+
+		pom, err := afero.ReadFile(fs.FS, "pom.xml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(pom)).To(ContainSubstring("<artifactId>kubernetes-webhooks-framework-core</artifactId>"))
+		Expect(string(pom)).To(ContainSubstring("<josdk-webhooks.version>"))
+
+		updated, err := cfg.GetResource(res.GVK)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated.HasDefaultingWebhook()).To(BeTrue())
+	})
+
+	It("keeps previously scaffolded webhooks in the endpoint", func() {
+		res.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Defaulting: true}
+		scaffolder := NewCreateWebhookScaffolder(cfg, pluginConfig, res, false)
+		scaffolder.InjectFS(fs)
+		Expect(scaffolder.Scaffold()).To(Succeed())
+
+		res.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Validation: true}
+		scaffolder = NewCreateWebhookScaffolder(cfg, pluginConfig, res, true)
+		scaffolder.InjectFS(fs)
+		Expect(scaffolder.Scaffold()).To(Succeed())
+
+		endpoint, err := afero.ReadFile(fs.FS, javaFile("MemcachedWebhookEndpoint.java"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(endpoint)).To(ContainSubstring("mutate-cache-example-com-v1-memcached"))
+		Expect(string(endpoint)).To(ContainSubstring("validate-cache-example-com-v1-memcached"))
+
+		pom, err := afero.ReadFile(fs.FS, "pom.xml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(strings.Count(string(pom), "<artifactId>kubernetes-webhooks-framework-core</artifactId>")).To(Equal(1))
+	})
+
+	It("converts the versions to and from the storage version", func() {
+		v2 := res
+		v2.Version = "v2"
+		v2.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Conversion: true}
+		Expect(cfg.AddResource(v2)).To(Succeed())
+
+		storagePluginConfig := pluginConfig
+		storagePluginConfig.SetStorageVersion(res)
+		scaffolder := NewCreateWebhookScaffolder(cfg, storagePluginConfig, v2, false)
+		scaffolder.InjectFS(fs)
+		Expect(scaffolder.Scaffold()).To(Succeed())
+
+		mapper, err := afero.ReadFile(fs.FS, filepath.Join("src", "main", "java", "com", "example", "v2", "MemcachedMapper.java"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(mapper)).To(ContainSubstring("package com.example.v2;"))
+		Expect(string(mapper)).To(ContainSubstring(
+			"public class MemcachedMapper implements Mapper<Memcached, com.example.v1.Memcached> {"))
+		Expect(string(mapper)).To(ContainSubstring("public com.example.v1.Memcached toHub(Memcached resource) {"))
+		Expect(afero.Exists(fs.FS, filepath.Join("src", "main", "java", "com", "example", "v2",
+			"MemcachedWebhookEndpoint.java"))).To(BeFalse())
+	})
+
+	It("serves the conversion webhook of every version from a single endpoint", func() {
+		endpointPath := filepath.Join("src", "main", "java", "com", "example", "MemcachedConversionEndpoint.java")
+		pluginConfig.SetStorageVersion(res)
+		for _, version := range []string{"v1", "v2"} {
+			versioned := res
+			versioned.Version = version
+			versioned.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Conversion: true}
+			scaffolder := NewCreateWebhookScaffolder(cfg, pluginConfig, versioned, false)
+			scaffolder.InjectFS(fs)
+			Expect(scaffolder.Scaffold()).To(Succeed())
+
+			if version == "v1" {
+				Expect(scaffolder.Edited()).To(BeEmpty())
+
+				// The endpoint is extended rather than regenerated, keeping the changes made to it
+				endpoint, err := afero.ReadFile(fs.FS, endpointPath)
+				Expect(err).NotTo(HaveOccurred())
+				edited := strings.Replace(string(endpoint), "@Path(\"/\")", "@Path(\"/\")\n@Blocking", 1)
+				Expect(afero.WriteFile(fs.FS, endpointPath, []byte(edited), 0644)).To(Succeed())
+			} else {
+				Expect(scaffolder.Edited()).To(ConsistOf(endpointPath))
+			}
+		}
+
+		endpoint, err := afero.ReadFile(fs.FS, endpointPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(endpoint)).To(ContainSubstring("@Path(\"/\")\n@Blocking\n"))
+		Expect(string(endpoint)).To(ContainSubstring("package com.example;"))
+		Expect(string(endpoint)).To(ContainSubstring(
+			"    conversionController.registerMapper(new com.example.v1.MemcachedMapper());\n" +
+				"    conversionController.registerMapper(new com.example.v2.MemcachedMapper());\n"))
+		Expect(string(endpoint)).To(ContainSubstring(`@Path("convert-cache-example-com-memcached")`))
+	})
+})
@@ -0,0 +1,231 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+var (
+	// javaLiterals cannot be used as identifiers, like keywords
+	javaLiterals = map[string]bool{
+		"true":  true,
+		"false": true,
+		"null":  true,
+	}
+
+	// javaRestrictedIdentifiers cannot be used as type names, and are avoided in every generated identifier
+	javaRestrictedIdentifiers = map[string]bool{
+		"var":     true,
+		"yield":   true,
+		"record":  true,
+		"sealed":  true,
+		"permits": true,
+	}
+
+	// reservedClassNames maps the simple names of the classes the generated sources use, either implicitly from
+	// java.lang and kotlin or through imports, to their qualified names. Generated classes of the same name would
+	// shadow them.
+	reservedClassNames = map[string]string{
+		"Boolean":                 "java.lang.Boolean",
+		"Byte":                    "java.lang.Byte",
+		"Character":               "java.lang.Character",
+		"Class":                   "java.lang.Class",
+		"ClassLoader":             "java.lang.ClassLoader",
+		"Cloneable":               "java.lang.Cloneable",
+		"Comparable":              "java.lang.Comparable",
+		"Deprecated":              "java.lang.Deprecated",
+		"Double":                  "java.lang.Double",
+		"Enum":                    "java.lang.Enum",
+		"Error":                   "java.lang.Error",
+		"Exception":               "java.lang.Exception",
+		"Float":                   "java.lang.Float",
+		"Integer":                 "java.lang.Integer",
+		"Iterable":                "java.lang.Iterable",
+		"Long":                    "java.lang.Long",
+		"Math":                    "java.lang.Math",
+		"Number":                  "java.lang.Number",
+		"Object":                  "java.lang.Object",
+		"Override":                "java.lang.Override",
+		"Package":                 "java.lang.Package",
+		"Record":                  "java.lang.Record",
+		"Runnable":                "java.lang.Runnable",
+		"Runtime":                 "java.lang.Runtime",
+		"RuntimeException":        "java.lang.RuntimeException",
+		"Short":                   "java.lang.Short",
+		"String":                  "java.lang.String",
+		"StringBuilder":           "java.lang.StringBuilder",
+		"SuppressWarnings":        "java.lang.SuppressWarnings",
+		"System":                  "java.lang.System",
+		"Thread":                  "java.lang.Thread",
+		"Throwable":               "java.lang.Throwable",
+		"Void":                    "java.lang.Void",
+		"Any":                     "kotlin.Any",
+		"Int":                     "kotlin.Int",
+		"Nothing":                 "kotlin.Nothing",
+		"Unit":                    "kotlin.Unit",
+		"List":                    "java.util.List",
+		"Map":                     "java.util.Map",
+		"CustomResource":          "io.fabric8.kubernetes.client.CustomResource",
+		"KubernetesClient":        "io.fabric8.kubernetes.client.KubernetesClient",
+		"Namespaced":              "io.fabric8.kubernetes.api.model.Namespaced",
+		"IntOrString":             "io.fabric8.kubernetes.api.model.IntOrString",
+		"Categories":              "io.fabric8.kubernetes.model.annotation.Categories",
+		"Group":                   "io.fabric8.kubernetes.model.annotation.Group",
+		"Kind":                    "io.fabric8.kubernetes.model.annotation.Kind",
+		"Plural":                  "io.fabric8.kubernetes.model.annotation.Plural",
+		"PrinterColumn":           "io.fabric8.crd.generator.annotation.PrinterColumn",
+		"ShortNames":              "io.fabric8.kubernetes.model.annotation.ShortNames",
+		"SpecReplicas":            "io.fabric8.kubernetes.model.annotation.SpecReplicas",
+		"StatusReplicas":          "io.fabric8.kubernetes.model.annotation.StatusReplicas",
+		"Version":                 "io.fabric8.kubernetes.model.annotation.Version",
+		"Max":                     "io.fabric8.generator.annotation.Max",
+		"Min":                     "io.fabric8.generator.annotation.Min",
+		"Pattern":                 "io.fabric8.generator.annotation.Pattern",
+		"Required":                "io.fabric8.generator.annotation.Required",
+		"JsonProperty":            "com.fasterxml.jackson.annotation.JsonProperty",
+		"JsonPropertyDescription": "com.fasterxml.jackson.annotation.JsonPropertyDescription",
+		"Context":                 "io.javaoperatorsdk.operator.api.reconciler.Context",
+		"Reconciler":              "io.javaoperatorsdk.operator.api.reconciler.Reconciler",
+		"UpdateControl":           "io.javaoperatorsdk.operator.api.reconciler.UpdateControl",
+
+		// The classes of the optional capabilities of the reconcilers
+		"Cleaner":                  "io.javaoperatorsdk.operator.api.reconciler.Cleaner",
+		"DeleteControl":            "io.javaoperatorsdk.operator.api.reconciler.DeleteControl",
+		"ErrorStatusHandler":       "io.javaoperatorsdk.operator.api.reconciler.ErrorStatusHandler",
+		"ErrorStatusUpdateControl": "io.javaoperatorsdk.operator.api.reconciler.ErrorStatusUpdateControl",
+		"EventSource":              "io.javaoperatorsdk.operator.processing.event.source.EventSource",
+		"EventSourceContext":       "io.javaoperatorsdk.operator.api.reconciler.EventSourceContext",
+		"EventSourceInitializer":   "io.javaoperatorsdk.operator.api.reconciler.EventSourceInitializer",
+	}
+)
+
+// Warning tells how a name was changed into a legal and safe Java identifier
+type Warning struct {
+	// Name is the name as given
+	Name string
+
+	// Identifier is the Java identifier used instead
+	Identifier string
+
+	// Reasons tell why the name was changed, in the order the fixes were applied
+	Reasons []string
+}
+
+// String implements fmt.Stringer
+func (w Warning) String() string {
+	return fmt.Sprintf("%q %s, using %q instead", w.Name, strings.Join(w.Reasons, " and "), w.Identifier)
+}
+
+// IsReservedWord returns true if s is a Java keyword, literal or restricted identifier
+func IsReservedWord(s string) bool {
+	return IsJavaKeyword(s) || javaLiterals[s] || javaRestrictedIdentifiers[s]
+}
+
+// IsReservedClassName returns true if a generated class named name would shadow one of the classes the generated
+// sources use
+func IsReservedClassName(name string) bool {
+	_, reserved := reservedClassNames[name]
+	return reserved
+}
+
+// SanitizeIdentifier turns name into a legal Java identifier. The fixes are applied in a fixed order, each to the
+// result of the previous one: illegal characters are replaced with underscores, an underscore is prepended to a
+// leading digit, then an underscore is appended to a reserved word, the underscore alone included.
+func SanitizeIdentifier(name string) (string, *Warning) {
+	id := name
+	var reasons []string
+
+	if strings.IndexFunc(id, isNotIdentifierRune) >= 0 {
+		id = strings.Map(func(r rune) rune {
+			if isNotIdentifierRune(r) {
+				return '_'
+			}
+			return r
+		}, id)
+		reasons = append(reasons, "contains characters which are illegal in Java identifiers")
+	}
+
+	if id != "" && unicode.IsDigit([]rune(id)[0]) {
+		id = "_" + id
+		reasons = append(reasons, "starts with a digit")
+	}
+
+	if IsReservedWord(id) {
+		id += "_"
+		reasons = append(reasons, "is a reserved word in Java")
+	}
+
+	if len(reasons) == 0 {
+		return id, nil
+	}
+	return id, &Warning{Name: name, Identifier: id, Reasons: reasons}
+}
+
+// SanitizePackage turns each segment of name, a package or a domain, into a legal Java identifier. The empty
+// segments are dropped.
+func SanitizePackage(name string) (string, []Warning) {
+	var segments []string
+	var warnings []Warning
+	for _, segment := range strings.Split(name, ".") {
+		if segment == "" {
+			continue
+		}
+		id, warning := SanitizeIdentifier(segment)
+		if warning != nil {
+			warnings = append(warnings, *warning)
+		}
+		segments = append(segments, id)
+	}
+
+	pkg := strings.Join(segments, ".")
+	if name != "" && len(segments) != strings.Count(name, ".")+1 {
+		warnings = append(warnings, Warning{Name: name, Identifier: pkg, Reasons: []string{"contains empty segments"}})
+	}
+	return pkg, warnings
+}
+
+// ClassName returns the name of the Java class generated for name, which is suffixed if it would shadow one of
+// the classes the generated sources use
+func ClassName(name, suffix string) (string, *Warning) {
+	className, warning := SanitizeIdentifier(ToClassname(name))
+	if warning != nil {
+		warning.Name = name
+	}
+
+	if !IsReservedClassName(className) {
+		return className, warning
+	}
+
+	if warning == nil {
+		warning = &Warning{Name: name}
+	}
+	warning.Reasons = append(warning.Reasons, "clashes with "+reservedClassNames[className])
+	className += suffix
+	warning.Identifier = className
+	return className, warning
+}
+
+// KindClassName returns the name of the model class of a kind, suffixed with Resource if the kind clashes with the
+// classes the generated sources use, in which case the model declares its kind with an annotation
+func KindClassName(kind string) (string, *Warning) {
+	return ClassName(kind, "Resource")
+}
+
+func isNotIdentifierRune(r rune) bool {
+	return r != '_' && r != '$' && !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
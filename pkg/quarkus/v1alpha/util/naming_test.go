@@ -0,0 +1,122 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("naming", func() {
+	Describe("SanitizeIdentifier", func() {
+		It("leaves legal identifiers alone", func() {
+			id, warning := SanitizeIdentifier("memcached")
+			Expect(id).To(Equal("memcached"))
+			Expect(warning).To(BeNil())
+		})
+
+		It("applies every fix in order", func() {
+			id, warning := SanitizeIdentifier("1-class")
+			Expect(id).To(Equal("_1_class"))
+			Expect(warning.String()).To(Equal(`"1-class" contains characters which are illegal in Java identifiers ` +
+				`and starts with a digit, using "_1_class" instead`))
+		})
+
+		It("sanitizes the reserved literals and identifiers", func() {
+			for _, word := range []string{"true", "null", "var", "record", "yield", "int", "_"} {
+				id, warning := SanitizeIdentifier(word)
+				Expect(id).To(Equal(word + "_"))
+				Expect(warning.Reasons).To(Equal([]string{"is a reserved word in Java"}))
+			}
+		})
+
+		It("never returns the underscore alone", func() {
+			for name, expected := range map[string]string{
+				"-":   "__",
+				".":   "__",
+				"-.-": "___",
+				"__":  "__",
+			} {
+				id, _ := SanitizeIdentifier(name)
+				Expect(id).To(Equal(expected), name)
+			}
+		})
+	})
+
+	Describe("SanitizePackage", func() {
+		It("returns a warning per sanitized segment", func() {
+			pkg, warnings := SanitizePackage("com.1-class.null.example")
+			Expect(pkg).To(Equal("com._1_class.null_.example"))
+			Expect(warnings).To(HaveLen(2))
+			Expect(warnings[0].Name).To(Equal("1-class"))
+			Expect(warnings[1].Identifier).To(Equal("null_"))
+		})
+
+		It("returns legal packages", func() {
+			for name, expected := range map[string]string{
+				"com.example":   "com.example",
+				"com.-.io":      "com.__.io",
+				"com._":         "com.__",
+				"a..b":          "a.b",
+				".com.example.": "com.example",
+				"com..-":        "com.__",
+				"":              "",
+			} {
+				pkg, _ := SanitizePackage(name)
+				Expect(pkg).To(Equal(expected), name)
+				if pkg != "" {
+					Expect(ValidatePackage(pkg)).To(Succeed(), name)
+				}
+			}
+		})
+
+		It("warns about the empty segments", func() {
+			pkg, warnings := SanitizePackage("a..b")
+			Expect(pkg).To(Equal("a.b"))
+			Expect(warnings).To(HaveLen(1))
+			Expect(warnings[0].String()).To(Equal(`"a..b" contains empty segments, using "a.b" instead`))
+		})
+	})
+
+	Describe("KindClassName", func() {
+		It("returns the class name of safe kinds", func() {
+			className, warning := KindClassName("Memcached")
+			Expect(className).To(Equal("Memcached"))
+			Expect(warning).To(BeNil())
+		})
+
+		It("suffixes the kinds clashing with the classes of the generated sources", func() {
+			for kind, qualified := range map[string]string{
+				"Class":          "java.lang.Class",
+				"Object":         "java.lang.Object",
+				"Override":       "java.lang.Override",
+				"CustomResource": "io.fabric8.kubernetes.client.CustomResource",
+				"Version":        "io.fabric8.kubernetes.model.annotation.Version",
+			} {
+				className, warning := KindClassName(kind)
+				Expect(className).To(Equal(kind + "Resource"))
+				Expect(warning.String()).To(Equal(
+					`"` + kind + `" clashes with ` + qualified + `, using "` + kind + `Resource" instead`))
+			}
+		})
+
+		It("sanitizes the kinds before checking them", func() {
+			className, warning := KindClassName("3d-object")
+			Expect(className).To(Equal("_3dObject"))
+			Expect(warning.Name).To(Equal("3d-object"))
+			Expect(warning.Reasons).To(Equal([]string{"starts with a digit"}))
+		})
+	})
+})
@@ -17,6 +17,7 @@ package util
 import (
 	"fmt"
 	"strings"
+	"unicode"
 )
 
 var (
@@ -77,6 +78,8 @@ var (
 		"void":         0,
 		"volatile":     0,
 		"while":        0,
+		// The underscore alone is a keyword since Java 9
+		"_": 0,
 	}
 )
 
@@ -127,31 +130,68 @@ func ToClassname(s string) string {
 	return translateWord(ToCamel(s), true)
 }
 
+// SanitizeDomain turns each portion of the domain into a legal Java identifier, see SanitizePackage for the
+// warnings telling which portions were changed
 func SanitizeDomain(domain string) string {
-	// Split into the domain portions via "."
-	domainSplit := strings.Split(domain, ".")
-
-	// Loop through each portion of the domain
-	for i, domainPart := range domainSplit {
-		// If there are hyphens, replace with underscore
-		if strings.Contains(domainPart, "-") {
-			fmt.Printf("\ndomain portion (%s) contains hyphens ('-') and needs to be sanitized to create a legal Java package name. Replacing all hyphens with underscores ('_')\n", domainPart)
-			domainSplit[i] = strings.ReplaceAll(domainPart, "-", "_")
-		}
+	sanitized, _ := SanitizePackage(domain)
+	return sanitized
+}
 
-		// If any portion includes a keyword, replace with keyword_
-		if _, ok := javaKeywords[domainPart]; ok {
-			fmt.Printf("\ndomain portion (%s) is a Java keyword and needs to be sanitized to create a legal Java package name. Adding an underscore ('_') to the end of the domain portion\n", domainPart)
-			domainSplit[i] = domainPart + "_"
-		}
+// IsJavaKeyword returns true if s is a reserved Java keyword
+func IsJavaKeyword(s string) bool {
+	_, ok := javaKeywords[s]
+	return ok
+}
+
+// ValidatePackage returns an error if pkg is not a legal Java package name
+func ValidatePackage(pkg string) error {
+	if pkg == "" {
+		return fmt.Errorf("package name cannot be empty")
+	}
 
-		// If any portion starts with number, make it start with underscore
-		if domainPart != "" && domainPart[0] >= '0' && domainPart[0] <= '9' {
-			fmt.Printf("\ndomain portion(%s) begins with a digit and needs to be sanitized to create a legal Java package name. Adding an underscore('_') to the beginning of the domain portion\n", domainPart)
-			domainSplit[i] = "_" + domainPart
+	for _, part := range strings.Split(pkg, ".") {
+		if !isJavaIdentifier(part) {
+			return fmt.Errorf("package name (%s) is invalid: %q is not a legal Java identifier", pkg, part)
+		}
+		if IsJavaKeyword(part) {
+			return fmt.Errorf("package name (%s) is invalid: %q is a Java keyword", pkg, part)
+		}
+		if IsReservedWord(part) {
+			return fmt.Errorf("package name (%s) is invalid: %q is a reserved word in Java", pkg, part)
 		}
+	}
 
+	return nil
+}
+
+// ValidateMavenID returns an error if id is not a legal Maven group or artifact ID
+func ValidateMavenID(id string) error {
+	if id == "" {
+		return fmt.Errorf("Maven ID cannot be empty")
 	}
 
-	return strings.Join(domainSplit, ".")
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == '-', r == '.':
+		default:
+			return fmt.Errorf("Maven ID (%s) is invalid: only letters, digits, '_', '-' and '.' are allowed", id)
+		}
+	}
+
+	return nil
+}
+
+func isJavaIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		switch {
+		case r == '_' || r == '$' || unicode.IsLetter(r):
+		case i > 0 && unicode.IsDigit(r):
+		default:
+			return false
+		}
+	}
+	return true
 }
@@ -76,6 +76,47 @@ var _ = Describe("util", func() {
 		It("Sanitizes when begins with digit", func() {
 			Expect(SanitizeDomain("123name.example.123com")).To(Equal("_123name.example._123com"))
 		})
+
+		It("Sanitizes every issue of a portion", func() {
+			Expect(SanitizeDomain("1-class.true.example")).To(Equal("_1_class.true_.example"))
+		})
+	})
+
+	Describe("ValidatePackage", func() {
+		It("accepts legal package names", func() {
+			Expect(ValidatePackage("com.example")).To(Succeed())
+			Expect(ValidatePackage("com.acme.platform.operators.my_op")).To(Succeed())
+			Expect(ValidatePackage("_123name.example")).To(Succeed())
+		})
+
+		It("rejects illegal package names", func() {
+			Expect(ValidatePackage("")).NotTo(Succeed())
+			Expect(ValidatePackage("com..example")).NotTo(Succeed())
+			Expect(ValidatePackage("com.123example")).NotTo(Succeed())
+			Expect(ValidatePackage("com.my-op")).NotTo(Succeed())
+			Expect(ValidatePackage("com.example.int")).NotTo(Succeed())
+			Expect(ValidatePackage("com.example.null")).NotTo(Succeed())
+		})
+	})
+
+	Describe("ValidateMavenID", func() {
+		It("accepts legal IDs", func() {
+			Expect(ValidateMavenID("com.acme.platform")).To(Succeed())
+			Expect(ValidateMavenID("memcached-operator_2")).To(Succeed())
+		})
+
+		It("rejects illegal IDs", func() {
+			Expect(ValidateMavenID("")).NotTo(Succeed())
+			Expect(ValidateMavenID("com acme")).NotTo(Succeed())
+			Expect(ValidateMavenID("memcached/operator")).NotTo(Succeed())
+		})
+	})
+
+	Describe("IsJavaKeyword", func() {
+		It("detects the Java keywords", func() {
+			Expect(IsJavaKeyword("class")).To(BeTrue())
+			Expect(IsJavaKeyword("size")).To(BeFalse())
+		})
 	})
 
 })
@@ -0,0 +1,202 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/scaffolds"
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v3/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v3/pkg/plugin"
+	pluginutil "sigs.k8s.io/kubebuilder/v3/pkg/plugin/util"
+)
+
+// defaultWebhookVersion is the default mutating/validating webhook config API version to scaffold.
+const defaultWebhookVersion = "v1"
+
+type createWebhookOptions struct {
+	WebhookVersion string
+	Defaulting     bool
+	Validation     bool
+	Conversion     bool
+}
+
+type createWebhookSubcommand struct {
+	config       config.Config
+	pluginConfig scaffolds.PluginConfig
+	resource     *resource.Resource
+	options      createWebhookOptions
+
+	// For help text.
+	commandName string
+
+	// force indicates that the webhook files should be scaffolded even if they already exist
+	force bool
+
+	report reporter
+}
+
+func (opts createWebhookOptions) UpdateResource(res *resource.Resource) {
+	res.Webhooks = &resource.Webhooks{
+		WebhookVersion: opts.WebhookVersion,
+		Defaulting:     opts.Defaulting,
+		Validation:     opts.Validation,
+		Conversion:     opts.Conversion,
+	}
+
+	// Ensure that Path is empty and Controller false as this is not a Go project
+	res.Path = ""
+	res.Controller = false
+}
+
+var (
+	_ plugin.CreateWebhookSubcommand = &createWebhookSubcommand{}
+)
+
+func (p *createWebhookSubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
+	subcmdMeta.Description = `Scaffold a webhook for an API resource. You can choose to scaffold defaulting,
+validating and/or conversion webhooks.
+
+Writes the following files:
+- a mutator, validator and/or mapper class to implement the webhook logic
+- a REST endpoint serving the webhooks of the resource
+- the webhooks framework dependencies in the pom.xml file
+`
+	subcmdMeta.Examples = fmt.Sprintf(`  # Create defaulting and validating webhooks for Group: cache, Version: v1
+  # and Kind: Memcached
+  %[1]s create webhook --group cache --version v1 --kind Memcached --defaulting --programmatic-validation
+
+  # Create conversion webhook for Group: cache, Version: v1
+  # and Kind: Memcached
+  %[1]s create webhook --group cache --version v1 --kind Memcached --conversion
+`, cliMeta.CommandName)
+
+	p.commandName = cliMeta.CommandName
+}
+
+func (p *createWebhookSubcommand) BindFlags(fs *pflag.FlagSet) {
+	fs.SortFlags = false
+	p.options.WebhookVersion = defaultWebhookVersion
+	fs.BoolVar(&p.options.Defaulting, "defaulting", false, "if set, scaffold the defaulting webhook")
+	fs.BoolVar(&p.options.Validation, "programmatic-validation", false, "if set, scaffold the validating webhook")
+	fs.BoolVar(&p.options.Conversion, "conversion", false, "if set, scaffold the conversion webhook")
+	fs.BoolVar(&p.force, "force", false, "attempt to create the webhook even if it already exists")
+	p.report.bindFlags(fs)
+}
+
+func (p *createWebhookSubcommand) InjectConfig(c config.Config) error {
+	if err := p.report.validate(); err != nil {
+		return err
+	}
+	c, err := p.report.isolate(c)
+	if err != nil {
+		return err
+	}
+	p.config = c
+
+	pluginConfig, err := loadPluginConfig(c)
+	if err != nil {
+		return err
+	}
+	p.pluginConfig = pluginConfig
+
+	// The webhooks are only scaffolded in Java, which the sources of Kotlin projects are not mixed with
+	if pluginConfig.IsKotlin() {
+		return fmt.Errorf("%s create webhook does not support %s projects, whose webhooks have to be written by hand",
+			p.commandName, scaffolds.LanguageKotlin)
+	}
+
+	return nil
+}
+
+func (p *createWebhookSubcommand) InjectResource(res *resource.Resource) error {
+	p.resource = res
+
+	p.options.UpdateResource(p.resource)
+
+	if !p.resource.HasDefaultingWebhook() && !p.resource.HasValidationWebhook() && !p.resource.HasConversionWebhook() {
+		return fmt.Errorf("%s create webhook requires at least one of --defaulting,"+
+			" --programmatic-validation and --conversion to be true", p.commandName)
+	}
+
+	// Check that the resource has the API scaffolded
+	existing, err := p.config.GetResource(p.resource.GVK)
+	if err != nil || !existing.HasAPI() {
+		return errors.New("the API resource does not exist, create it before adding a webhook")
+	}
+	// Other webhook types can be added to the resource, only the ones already scaffolded require --force
+	if !p.force {
+		if existing := existingWebhooks(existing, *p.resource); len(existing) != 0 {
+			return fmt.Errorf("the %s webhook of the resource already exists, use --force to overwrite it",
+				strings.Join(existing, " and "))
+		}
+	}
+
+	// Keep the plural of the existing resource so that both can be merged
+	p.resource.Plural = existing.Plural
+
+	if err := p.resource.Validate(); err != nil {
+		return err
+	}
+
+	if pluginutil.HasDifferentWebhookVersion(p.config, p.resource.Webhooks.WebhookVersion) {
+		return fmt.Errorf("only one webhook version can be used for all resources, cannot add %q",
+			p.resource.Webhooks.WebhookVersion)
+	}
+
+	return nil
+}
+
+// existingWebhooks returns the types of the webhooks requested for res which the existing resource already has
+func existingWebhooks(existing, res resource.Resource) []string {
+	var types []string
+	if res.HasDefaultingWebhook() && existing.HasDefaultingWebhook() {
+		types = append(types, "defaulting")
+	}
+	if res.HasValidationWebhook() && existing.HasValidationWebhook() {
+		types = append(types, "validating")
+	}
+	if res.HasConversionWebhook() && existing.HasConversionWebhook() {
+		types = append(types, "conversion")
+	}
+	return types
+}
+
+func (p *createWebhookSubcommand) Scaffold(fs machinery.Filesystem) error {
+	return p.report.scaffold("create webhook", fs, p.config, p.scaffold)
+}
+
+// scaffold writes the files of the webhooks to fs
+func (p *createWebhookSubcommand) scaffold(fs machinery.Filesystem) error {
+	scaffolder := scaffolds.NewCreateWebhookScaffolder(p.config, p.pluginConfig, *p.resource, p.force)
+	scaffolder.InjectFS(fs)
+
+	if err := scaffolder.Scaffold(); err != nil {
+		return err
+	}
+	p.report.edit(scaffolder.Edited()...)
+	return nil
+}
+
+func (p *createWebhookSubcommand) PostScaffold() error {
+	return p.report.report(nextStep{Description: "implement your new webhook and register it in your cluster " +
+		"with a MutatingWebhookConfiguration, ValidatingWebhookConfiguration or CRD conversion strategy"})
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	"sigs.k8s.io/kubebuilder/v3/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v3/pkg/plugin"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/scaffolds"
+)
+
+var _ = Describe("v1", func() {
+	var (
+		testWebhookSubcommand createWebhookSubcommand
+		testConfig            config.Config
+		testResource          resource.Resource
+	)
+
+	BeforeEach(func() {
+		testWebhookSubcommand = createWebhookSubcommand{}
+		testWebhookSubcommand.BindFlags(pflag.NewFlagSet("testFlag", -1))
+
+		testConfig, _ = config.New(config.Version{Number: 3})
+		Expect(testConfig.AddResource(resource.Resource{
+			GVK:    resource.GVK{Group: "cache", Domain: "example.com", Version: "v1", Kind: "Memcached"},
+			Plural: "memcacheds",
+			API:    &resource.API{CRDVersion: "v1", Namespaced: true},
+		})).To(Succeed())
+		Expect(testWebhookSubcommand.InjectConfig(testConfig)).To(Succeed())
+
+		testResource = resource.Resource{
+			GVK:    resource.GVK{Group: "cache", Domain: "example.com", Version: "v1", Kind: "Memcached"},
+			Plural: "memcacheds",
+		}
+	})
+
+	Describe("InjectConfig", func() {
+		It("should refuse Kotlin projects", func() {
+			Expect(savePluginConfig(testConfig, scaffolds.PluginConfig{
+				Package:  "com.example",
+				Language: scaffolds.LanguageKotlin,
+			})).To(Succeed())
+			Expect(testWebhookSubcommand.InjectConfig(testConfig)).To(MatchError(ContainSubstring(
+				"does not support kotlin projects")))
+		})
+	})
+
+	Describe("UpdateMetadata", func() {
+		It("should set the command name", func() {
+			testWebhookSubcommand.UpdateMetadata(plugin.CLIMetadata{CommandName: "TestCommand"}, &plugin.SubcommandMetadata{})
+			Expect(testWebhookSubcommand.commandName).To(Equal("TestCommand"))
+		})
+	})
+
+	Describe("BindFlags", func() {
+		It("should set the default values", func() {
+			flagTest := pflag.NewFlagSet("testFlag", -1)
+			testWebhookSubcommand.BindFlags(flagTest)
+			Expect(flagTest.SortFlags).To(BeFalse())
+			Expect(testWebhookSubcommand.options.WebhookVersion).To(Equal("v1"))
+			Expect(testWebhookSubcommand.options.Defaulting).To(BeFalse())
+			Expect(testWebhookSubcommand.options.Validation).To(BeFalse())
+			Expect(testWebhookSubcommand.options.Conversion).To(BeFalse())
+			Expect(testWebhookSubcommand.force).To(BeFalse())
+		})
+	})
+
+	Describe("UpdateResource", func() {
+		It("verify that resource fields were set", func() {
+			testOptions := createWebhookOptions{WebhookVersion: "v1", Defaulting: true, Conversion: true}
+			updateTestResource := resource.Resource{Path: "some/path", Controller: true}
+			testOptions.UpdateResource(&updateTestResource)
+			Expect(updateTestResource.HasDefaultingWebhook()).To(BeTrue())
+			Expect(updateTestResource.HasValidationWebhook()).To(BeFalse())
+			Expect(updateTestResource.HasConversionWebhook()).To(BeTrue())
+			Expect(updateTestResource.Path).To(Equal(""))
+			Expect(updateTestResource.Controller).To(BeFalse())
+		})
+	})
+
+	Describe("InjectResource", func() {
+		It("should fail when no webhook type is requested", func() {
+			Expect(testWebhookSubcommand.InjectResource(&testResource)).To(HaveOccurred())
+		})
+
+		It("should fail when the API does not exist", func() {
+			testWebhookSubcommand.options.Defaulting = true
+			testResource.Kind = "Other"
+			testResource.Plural = "others"
+			Expect(testWebhookSubcommand.InjectResource(&testResource)).To(HaveOccurred())
+		})
+
+		It("should succeed for an existing API", func() {
+			testWebhookSubcommand.options.Validation = true
+			Expect(testWebhookSubcommand.InjectResource(&testResource)).To(Succeed())
+			Expect(testWebhookSubcommand.resource.HasValidationWebhook()).To(BeTrue())
+		})
+
+		It("should add other webhook types to the existing ones", func() {
+			existing, _ := testConfig.GetResource(testResource.GVK)
+			existing.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Defaulting: true}
+			Expect(testConfig.UpdateResource(existing)).To(Succeed())
+
+			testWebhookSubcommand.options.Validation = true
+			Expect(testWebhookSubcommand.InjectResource(&testResource)).To(Succeed())
+		})
+
+		It("should fail when the webhook type already exists unless forced", func() {
+			existing, _ := testConfig.GetResource(testResource.GVK)
+			existing.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Defaulting: true}
+			Expect(testConfig.UpdateResource(existing)).To(Succeed())
+
+			testWebhookSubcommand.options.Defaulting = true
+			testWebhookSubcommand.options.Validation = true
+			Expect(testWebhookSubcommand.InjectResource(&testResource)).To(MatchError(
+				"the defaulting webhook of the resource already exists, use --force to overwrite it"))
+
+			testWebhookSubcommand.force = true
+			Expect(testWebhookSubcommand.InjectResource(&testResource)).To(Succeed())
+		})
+	})
+
+	Describe("PostScaffold", func() {
+		It("should return nil", func() {
+			Expect(testWebhookSubcommand.PostScaffold()).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,64 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package templates
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/templates/util"
+)
+
+var _ machinery.Template = &ApplicationFile{}
+
+// ApplicationFile scaffolds the Spring Boot application class starting the operator
+type ApplicationFile struct {
+	machinery.TemplateMixin
+
+	// Package is the source files package
+	Package string
+
+	// ClassName is the name of the application class, without the Application suffix
+	ClassName string
+}
+
+func (f *ApplicationFile) SetTemplateDefaults() error {
+	if f.ClassName == "" {
+		return fmt.Errorf("invalid application name")
+	}
+
+	if f.Path == "" {
+		f.Path = util.PrependJavaPath(f.ClassName+"Application.java", util.AsPath(f.Package))
+	}
+
+	f.TemplateBody = applicationTemplate
+
+	return nil
+}
+
+const applicationTemplate = `package {{ .Package }};
+
+import org.springframework.boot.SpringApplication;
+import org.springframework.boot.autoconfigure.SpringBootApplication;
+
+@SpringBootApplication
+public class {{ .ClassName }}Application {
+
+  public static void main(String[] args) {
+    SpringApplication.run({{ .ClassName }}Application.class, args);
+  }
+}
+`
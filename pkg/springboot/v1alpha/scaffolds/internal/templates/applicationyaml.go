@@ -0,0 +1,48 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package templates
+
+import (
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/templates/util"
+)
+
+var _ machinery.Template = &ApplicationYamlFile{}
+
+// ApplicationYamlFile scaffolds the Spring Boot application configuration
+type ApplicationYamlFile struct {
+	machinery.TemplateMixin
+
+	ProjectName string
+}
+
+func (f *ApplicationYamlFile) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = util.PrependResourcePath("application.yaml")
+	}
+
+	f.TemplateBody = applicationYamlTemplate
+
+	return nil
+}
+
+const applicationYamlTemplate = `spring:
+  application:
+    name: {{ .ProjectName }}
+
+# The operator and its reconcilers are configured under the javaoperatorsdk key,
+# e.g. javaoperatorsdk.reconcilers.<reconciler name>.namespaces
+`
@@ -0,0 +1,102 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package templates
+
+import (
+	"errors"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+var _ machinery.Template = &PomXmlFile{}
+
+// PomXmlFile scaffolds the pom.xml of a project based on the Spring Boot starter parent
+type PomXmlFile struct {
+	machinery.TemplateMixin
+
+	// Package is the source files package
+	Package         string
+	ProjectName     string
+	OperatorVersion string
+
+	// SpringBootVersion is the version of Spring Boot used to build the project
+	SpringBootVersion string
+}
+
+func (f *PomXmlFile) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = "pom.xml"
+	}
+
+	f.TemplateBody = pomxmlTemplate
+
+	if f.SpringBootVersion == "" {
+		return errors.New("spring boot version is required in scaffold")
+	}
+
+	return nil
+}
+
+const pomxmlTemplate = `<?xml version="1.0" encoding="UTF-8"?>
+<project xmlns="http://maven.apache.org/POM/4.0.0"
+  xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"
+  xsi:schemaLocation="http://maven.apache.org/POM/4.0.0 http://maven.apache.org/xsd/maven-4.0.0.xsd">
+  <modelVersion>4.0.0</modelVersion>
+  <parent>
+    <groupId>org.springframework.boot</groupId>
+    <artifactId>spring-boot-starter-parent</artifactId>
+    <version>{{ .SpringBootVersion }}</version>
+    <relativePath/>
+  </parent>
+  <groupId>{{ .Package }}</groupId>
+  <artifactId>{{ .ProjectName }}</artifactId>
+  <name>{{ .ProjectName }}</name>
+  <version>{{ .OperatorVersion }}-SNAPSHOT</version>
+  <packaging>jar</packaging>
+  <properties>
+    <java.version>11</java.version>
+    <maven.compiler.parameters>true</maven.compiler.parameters>
+    <project.build.sourceEncoding>UTF-8</project.build.sourceEncoding>
+    <project.reporting.outputEncoding>UTF-8</project.reporting.outputEncoding>
+    <josdk-spring-boot.version>2.1.1</josdk-spring-boot.version>
+    <fabric8-client.version>5.12.2</fabric8-client.version>
+  </properties>
+
+  <dependencies>
+    <dependency>
+      <groupId>io.javaoperatorsdk</groupId>
+      <artifactId>operator-framework-spring-boot-starter</artifactId>
+      <version>${josdk-spring-boot.version}</version>
+    </dependency>
+    <dependency>
+      <!-- Generates the CRDs under target/classes/META-INF/fabric8 when compiling the models -->
+      <groupId>io.fabric8</groupId>
+      <artifactId>crd-generator-apt</artifactId>
+      <version>${fabric8-client.version}</version>
+      <scope>provided</scope>
+    </dependency>
+  </dependencies>
+
+  <build>
+    <plugins>
+      <plugin>
+        <groupId>org.springframework.boot</groupId>
+        <artifactId>spring-boot-maven-plugin</artifactId>
+      </plugin>
+    </plugins>
+  </build>
+
+</project>
+`
@@ -0,0 +1,70 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffolds
+
+import (
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/josdk"
+	josdktemplates "github.com/operator-framework/java-operator-plugins/pkg/internal/josdk/templates"
+	"github.com/operator-framework/java-operator-plugins/pkg/quarkus/v1alpha/util"
+	"github.com/operator-framework/java-operator-plugins/pkg/springboot/v1alpha/scaffolds/internal/templates"
+)
+
+const (
+	// DefaultSpringBootVersion is the Spring Boot version new projects are built with
+	DefaultSpringBootVersion = "2.6.7"
+)
+
+var _ josdk.Templates = Templates{}
+
+// Templates supplies the files of the projects based on the java-operator-sdk Spring Boot starter
+type Templates struct{}
+
+// NewPluginConfig implements josdk.Templates
+func (Templates) NewPluginConfig(javaPackage string) josdk.PluginConfig {
+	return josdk.PluginConfig{
+		Package:           javaPackage,
+		SpringBootVersion: DefaultSpringBootVersion,
+	}
+}
+
+// Init implements josdk.Templates
+func (Templates) Init(cfg config.Config, pluginConfig josdk.PluginConfig) []machinery.Builder {
+	return []machinery.Builder{
+		&templates.PomXmlFile{
+			Package:           pluginConfig.Package,
+			ProjectName:       cfg.GetProjectName(),
+			OperatorVersion:   "0.0.1",
+			SpringBootVersion: pluginConfig.SpringBootVersion,
+		},
+		&templates.ApplicationFile{
+			Package:   pluginConfig.Package,
+			ClassName: util.ToClassname(cfg.GetProjectName()),
+		},
+		&templates.ApplicationYamlFile{
+			ProjectName: cfg.GetProjectName(),
+		},
+		&templates.Makefile{},
+	}
+}
+
+// API implements josdk.Templates, registering the reconciler as a Spring component
+func (Templates) API(_ config.Config, pluginConfig josdk.PluginConfig, className string) []machinery.Builder {
+	return []machinery.Builder{
+		&josdktemplates.Controller{Package: pluginConfig.Package, ClassName: className, Component: true},
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaffolds
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"sigs.k8s.io/kubebuilder/v3/pkg/config"
+	v3 "sigs.k8s.io/kubebuilder/v3/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v3/pkg/model/resource"
+
+	"github.com/operator-framework/java-operator-plugins/pkg/internal/josdk"
+)
+
+var _ = Describe("Spring Boot scaffolders", func() {
+	var (
+		fs           machinery.Filesystem
+		cfg          config.Config
+		pluginConfig josdk.PluginConfig
+	)
+
+	readFile := func(path string) string {
+		contents, err := afero.ReadFile(fs.FS, path)
+		Expect(err).NotTo(HaveOccurred())
+		return string(contents)
+	}
+
+	BeforeEach(func() {
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		cfg = v3.New()
+		Expect(cfg.SetDomain("example.com")).To(Succeed())
+		Expect(cfg.SetProjectName("memcached-operator")).To(Succeed())
+		pluginConfig = josdk.PluginConfig{Package: "com.example", SpringBootVersion: DefaultSpringBootVersion}
+
+		scaffolder := josdk.NewInitScaffolder(cfg, pluginConfig, Templates{})
+		scaffolder.InjectFS(fs)
+		Expect(scaffolder.Scaffold()).To(Succeed())
+	})
+
+	It("sets up new projects with the default version", func() {
+		Expect(Templates{}.NewPluginConfig("com.example")).To(Equal(pluginConfig))
+	})
+
+	It("scaffolds a Spring Boot project", func() {
+		pom := readFile("pom.xml")
+		Expect(pom).To(ContainSubstring("<artifactId>spring-boot-starter-parent</artifactId>\n    <version>" + DefaultSpringBootVersion + "</version>"))
+		Expect(pom).To(ContainSubstring("<artifactId>operator-framework-spring-boot-starter</artifactId>"))
+		Expect(pom).To(ContainSubstring("<groupId>com.example</groupId>"))
+
+		Expect(readFile("src/main/java/com/example/MemcachedOperatorApplication.java")).To(ContainSubstring(
+			"SpringApplication.run(MemcachedOperatorApplication.class, args);"))
+		Expect(readFile("src/main/resources/application.yaml")).To(ContainSubstring("name: memcached-operator\n"))
+		Expect(readFile("Makefile")).To(ContainSubstring("mvn spring-boot:build-image"))
+		Expect(afero.Exists(fs.FS, ".gitignore")).To(BeTrue())
+	})
+
+	It("scaffolds the API as a Spring component", func() {
+		res := resource.Resource{
+			GVK:    resource.GVK{Group: "cache", Domain: "example.com", Version: "v1", Kind: "Memcached"},
+			Plural: "memcacheds",
+			API:    &resource.API{CRDVersion: "v1", Namespaced: true},
+		}
+		scaffolder := josdk.NewCreateAPIScaffolder(cfg, pluginConfig, res, Templates{})
+		scaffolder.InjectFS(fs)
+		Expect(scaffolder.Scaffold()).To(Succeed())
+
+		Expect(readFile("src/main/java/com/example/Memcached.java")).To(ContainSubstring(
+			"public class Memcached extends CustomResource<MemcachedSpec, MemcachedStatus> implements Namespaced"))
+		Expect(afero.Exists(fs.FS, "src/main/java/com/example/MemcachedSpec.java")).To(BeTrue())
+		Expect(afero.Exists(fs.FS, "src/main/java/com/example/MemcachedStatus.java")).To(BeTrue())
+		Expect(readFile("src/main/java/com/example/MemcachedReconciler.java")).To(ContainSubstring(
+			"@Component\n@ControllerConfiguration\npublic class MemcachedReconciler implements Reconciler<Memcached> {"))
+		Expect(cfg.HasResource(res.GVK)).To(BeTrue())
+	})
+})